@@ -2,17 +2,21 @@ package atom
 
 import (
 	"iter"
+	"net/url"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/htmltext"
 	"github.com/dsh2dsh/gofeed/v2/internal/json"
 )
 
 // Feed is an Atom Feed
 type Feed struct {
 	Title         string         `json:"title,omitempty"`
+	TitleType     string         `json:"titleType,omitempty"`
 	ID            string         `json:"id,omitempty"`
 	Updated       string         `json:"updated,omitempty"`
 	UpdatedParsed *time.Time     `json:"updatedParsed,omitempty"`
@@ -30,6 +34,8 @@ type Feed struct {
 	Youtube       *ext.Youtube   `json:"youtube,omitempty"`
 	Extensions    ext.Extensions `json:"extensions,omitempty"`
 	Version       string         `json:"version,omitempty"`
+
+	UnknownElements []ext.Extension `json:"unknownElements,omitempty"`
 }
 
 // Link is an Atom link that defines a reference
@@ -41,6 +47,7 @@ type Link struct {
 	Type     string `json:"type,omitempty"`
 	Title    string `json:"title,omitempty"`
 	Length   string `json:"length,omitempty"`
+	Count    string `json:"count,omitempty"` // thr:count of rel="replies"
 }
 
 // Generator identifies the agent used to generate a
@@ -71,19 +78,18 @@ func (self *Feed) String() string {
 	return s
 }
 
-func (self *Feed) GetLink() string {
-	if l := firstLinkWithType("alternate", self.Links); l != nil {
-		return l.Href
-	}
-
-	for _, l := range self.Links {
-		if l.Rel == "" && (l.Type == "" || l.Type == "text/html") {
-			return l.Href
-		}
-	}
-	return ""
+// TitleText returns Title as plain text. If TitleType is HTML, it strips HTML
+// tags and decodes entities. Title itself is kept as is.
+func (self *Feed) TitleText() string {
+	return titleText(self.Title, self.TitleType)
 }
 
+// TitleHTML returns true if Title contains HTML markup, according to
+// TitleType.
+func (self *Feed) TitleHTML() bool { return htmlType(self.TitleType) }
+
+func (self *Feed) GetLink() string { return alternateLink(self.Links) }
+
 func (self *Feed) GetFeedLink() string {
 	if feedLink := firstLinkWithType("self", self.Links); feedLink != nil {
 		return feedLink.Href
@@ -102,6 +108,29 @@ func (self *Feed) GetLinks() (links []string) {
 
 func (self *Feed) GetAuthor() *Person { return firstPerson(self.Authors) }
 
+// PrimaryAuthor returns the author, who is most likely the owner of the feed,
+// from multiple authors of the feed. It prefers the author, whose URI or email
+// belongs to the host of the generator URI, otherwise it returns the first
+// author. All authors are kept in Authors.
+func (self *Feed) PrimaryAuthor() *Person {
+	if self.Generator == nil || self.Generator.URI == "" {
+		return self.GetAuthor()
+	}
+
+	u, err := url.Parse(self.Generator.URI)
+	if err != nil || u.Hostname() == "" {
+		return self.GetAuthor()
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, p := range self.Authors {
+		if p.sameHost(host) {
+			return p
+		}
+	}
+	return self.GetAuthor()
+}
+
 func (self *Feed) ImageURL() string {
 	if self.Logo != "" {
 		return self.Logo
@@ -124,6 +153,24 @@ func (self *Feed) GetGenerator() string {
 	return strings.TrimSpace(generator)
 }
 
+// GeneratorName returns name of the generator, its text, like "WordPress". It
+// returns empty string if the feed has no generator.
+func (self *Feed) GeneratorName() string {
+	if self.Generator == nil {
+		return ""
+	}
+	return self.Generator.Value
+}
+
+// GeneratorVersion returns version attribute of the generator, like "6.4". It
+// returns empty string if the feed has no generator or it has no version.
+func (self *Feed) GeneratorVersion() string {
+	if self.Generator == nil {
+		return ""
+	}
+	return self.Generator.Version
+}
+
 func (self *Feed) GetCategories() []string {
 	if len(self.Categories) == 0 {
 		return nil
@@ -149,6 +196,41 @@ func firstLinkWithType(linkType string, links []*Link) *Link {
 	return nil
 }
 
+func alternateLink(links []*Link) string {
+	if l := firstLinkWithType("alternate", links); l != nil {
+		return l.Href
+	}
+
+	for _, l := range links {
+		if l.Rel == "" && (l.Type == "" || l.Type == "text/html") {
+			return l.Href
+		}
+	}
+	return ""
+}
+
+func titleText(title, titleType string) string {
+	if htmlType(titleType) {
+		return htmltext.Strip(title)
+	}
+	return title
+}
+
+func htmlType(textType string) bool {
+	attrs := textAttributes{Type: textType}
+	return attrs.HTML()
+}
+
+func (self *Person) sameHost(host string) bool {
+	if _, domain, ok := strings.Cut(self.Email, "@"); ok &&
+		strings.EqualFold(strings.TrimSpace(domain), host) {
+		return true
+	}
+
+	u, err := url.Parse(self.URI)
+	return err == nil && strings.EqualFold(u.Hostname(), host)
+}
+
 func firstPerson(persons []*Person) *Person {
 	if len(persons) == 0 {
 		return nil
@@ -159,6 +241,7 @@ func firstPerson(persons []*Person) *Person {
 // Entry is an Atom Entry
 type Entry struct {
 	Title           string         `json:"title,omitempty"`
+	TitleType       string         `json:"titleType,omitempty"`
 	ID              string         `json:"id,omitempty"`
 	Updated         string         `json:"updated,omitempty"`
 	UpdatedParsed   *time.Time     `json:"updatedParsed,omitempty"`
@@ -176,6 +259,8 @@ type Entry struct {
 	Media           *ext.Media     `json:"media,omitempty"`
 	Youtube         *ext.Youtube   `json:"youtube,omitempty"`
 	Extensions      ext.Extensions `json:"extensions,omitempty"`
+
+	UnknownElements []ext.Extension `json:"unknownElements,omitempty"`
 }
 
 // Content either contains or links to the content of
@@ -205,6 +290,17 @@ type Source struct {
 	Extensions    ext.Extensions `json:"extensions,omitempty"`
 }
 
+// GetLink returns alternate link of the source feed.
+func (self *Source) GetLink() string { return alternateLink(self.Links) }
+
+// GetFeedLink returns self link of the source feed.
+func (self *Source) GetFeedLink() string {
+	if l := firstLinkWithType("self", self.Links); l != nil {
+		return l.Href
+	}
+	return ""
+}
+
 func (self *Entry) GetContent() string {
 	if self.Content != nil {
 		return self.Content.Value
@@ -220,19 +316,18 @@ func (self *Entry) GetContent() string {
 	return ""
 }
 
-func (self *Entry) GetLink() string {
-	if l := firstLinkWithType("alternate", self.Links); l != nil {
-		return l.Href
-	}
-
-	for _, l := range self.Links {
-		if l.Rel == "" && (l.Type == "" || l.Type == "text/html") {
-			return l.Href
-		}
-	}
-	return ""
+// TitleText returns Title as plain text. If TitleType is HTML, it strips HTML
+// tags and decodes entities. Title itself is kept as is.
+func (self *Entry) TitleText() string {
+	return titleText(self.Title, self.TitleType)
 }
 
+// TitleHTML returns true if Title contains HTML markup, according to
+// TitleType.
+func (self *Entry) TitleHTML() bool { return htmlType(self.TitleType) }
+
+func (self *Entry) GetLink() string { return alternateLink(self.Links) }
+
 func (self *Entry) GetLinks() []string {
 	if len(self.Links) == 0 {
 		return nil
@@ -247,6 +342,60 @@ func (self *Entry) GetLinks() []string {
 	return links
 }
 
+// RelatedLinks returns hrefs of links with rel="related".
+func (self *Entry) RelatedLinks() []string {
+	var links []string
+	for _, l := range self.Links {
+		if l.Rel == "related" && l.Href != "" {
+			links = append(links, l.Href)
+		}
+	}
+	return links
+}
+
+// EditLink returns href of the first link with rel="edit", which an Atom
+// Publishing Protocol client uses to update or delete the entry.
+func (self *Entry) EditLink() string {
+	if l := firstLinkWithType("edit", self.Links); l != nil {
+		return l.Href
+	}
+	return ""
+}
+
+// EditMediaLink returns href of the first link with rel="edit-media", which
+// points to the editable media resource of the entry.
+func (self *Entry) EditMediaLink() string {
+	if l := firstLinkWithType("edit-media", self.Links); l != nil {
+		return l.Href
+	}
+	return ""
+}
+
+// RepliesLink returns href of the first link with rel="replies", which points
+// to comment feed of the entry.
+func (self *Entry) RepliesLink() string {
+	if l := firstLinkWithType("replies", self.Links); l != nil {
+		return l.Href
+	}
+	return ""
+}
+
+// RepliesCount returns thr:count of the first link with rel="replies", which
+// is number of comments of the entry. The second result is false, if the link
+// is missing or has no valid count.
+func (self *Entry) RepliesCount() (int, bool) {
+	l := firstLinkWithType("replies", self.Links)
+	if l == nil {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(l.Count))
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
+
 func (self *Entry) GetPublished() string {
 	if self.Published != "" {
 		return self.Published
@@ -1,7 +1,9 @@
 package atom
 
 import (
+	"crypto/sha1"
 	"encoding/base64"
+	"encoding/hex"
 	stdxml "encoding/xml"
 	"fmt"
 	"io"
@@ -60,16 +62,43 @@ func (self *Parser) Parse(r io.Reader, opts ...options.Option) (*Feed, error) {
 	self.p = xml.NewParser(r, opts...)
 
 	if _, err := self.p.FindRoot(); err != nil {
-		return nil, fmt.Errorf("gofeed/atom: %w", err)
+		return nil, xml.CategorizeErr(fmt.Errorf("gofeed/atom: %w", err))
 	}
 
 	self.root()
 	if err := self.Err(); err != nil {
-		return nil, err
+		return nil, xml.CategorizeErr(err)
 	}
 	return self.feed, nil
 }
 
+// Warnings returns problems, which the parser worked around during last
+// parse, like unsupported charset with [options.WithCharsetFallback].
+func (self *Parser) Warnings() []error {
+	if self.p == nil {
+		return nil
+	}
+	return self.p.Warnings()
+}
+
+// Charset returns charset of last parsed feed, declared by its XML prolog. It
+// returns "utf-8" if the feed doesn't declare another charset.
+func (self *Parser) Charset() string {
+	if self.p == nil {
+		return ""
+	}
+	return self.p.Charset()
+}
+
+// Stylesheet returns href of xml-stylesheet processing instruction of last
+// parsed feed, if it was parsed with [options.WithKeepStylesheet].
+func (self *Parser) Stylesheet() string {
+	if self.p == nil {
+		return ""
+	}
+	return self.p.Stylesheet()
+}
+
 func (self *Parser) Err() error {
 	switch {
 	case self.err != nil:
@@ -81,6 +110,12 @@ func (self *Parser) Err() error {
 }
 
 func (self *Parser) root() {
+	if !strings.EqualFold(self.p.Name, "feed") {
+		self.err = fmt.Errorf("gofeed/atom: unexpected root element %q",
+			self.p.Name)
+		return
+	}
+
 	children := self.makeChildrenSeq(self.p.Name)
 	if children == nil {
 		return
@@ -148,6 +183,7 @@ func (self *Parser) feedBody(name string) {
 	atom := self.feed
 	switch name {
 	case "title":
+		atom.TitleType = self.textAttributes().Type
 		atom.Title = self.text(name)
 	case "id":
 		atom.ID = self.text(name)
@@ -176,7 +212,9 @@ func (self *Parser) feedBody(name string) {
 	default:
 		// For non-standard Atom feed elements, add them to extensions
 		// under a special "_custom" namespace prefix
-		if e, ok := self.parseCustomExtInto(name, atom.Extensions); ok {
+		if self.opts.UnknownElementsSeparate {
+			atom.UnknownElements = self.appendUnknown(name, atom.UnknownElements)
+		} else if e, ok := self.parseCustomExtInto(name, atom.Extensions); ok {
 			atom.Extensions = e
 		}
 	}
@@ -207,7 +245,8 @@ func (self *Parser) extensions(name string, e ext.Extensions) ext.Extensions {
 		self.p.Skip(name)
 		return e
 	}
-	e, err := shared.ParseExtension(e, self.p.XMLPullParser)
+	e, err := shared.ParseExtension(e, self.p.XMLPullParser,
+		self.p.NamespacePrefix())
 	if err != nil {
 		self.err = err
 	}
@@ -221,16 +260,71 @@ func (self *Parser) appendEntry(name string, entries []*Entry) []*Entry {
 	}
 
 	entry := &Entry{Language: self.language()}
-	for name := range children {
-		self.entryBody(name, entry)
+	for child := range children {
+		self.entryBody(child, entry)
+		if self.err == nil && dateElements[child] && !self.entrySince(entry) {
+			// Don't waste time on the rest of the old entry.
+			self.err = self.p.SkipRest(name)
+			return entries
+		}
 	}
 
 	if self.err != nil {
 		return entries
 	}
+
+	if entry.ID == "" && self.opts.Lenient {
+		self.synthesizeID(entry)
+	}
 	return append(entries, entry)
 }
 
+// synthesizeID sets ID of the entry without <id> to its alternate link. If the
+// entry has no alternate link, the ID is a hash of its first link, updated
+// date and title.
+func (self *Parser) synthesizeID(entry *Entry) {
+	if link := entry.GetLink(); link != "" {
+		entry.ID = link
+	} else {
+		var link string
+		if len(entry.Links) != 0 {
+			link = entry.Links[0].Href
+		}
+
+		if link == "" && entry.Updated == "" && entry.Title == "" {
+			return
+		}
+		sum := sha1.Sum([]byte(link + "\n" + entry.Updated + "\n" + entry.Title))
+		entry.ID = "urn:sha1:" + hex.EncodeToString(sum[:])
+	}
+
+	self.p.Warn(fmt.Errorf("gofeed/atom: entry without id, synthesized %q",
+		entry.ID))
+}
+
+// dateElements are names of entry elements, which can change result of
+// entrySince.
+var dateElements = map[string]bool{
+	"updated":   true,
+	"modified":  true,
+	"published": true,
+	"issued":    true,
+}
+
+func (self *Parser) entrySince(entry *Entry) bool {
+	since := self.opts.ItemsSince
+	if since.IsZero() {
+		return true
+	}
+
+	updated, published := entry.UpdatedParsed, entry.PublishedParsed
+	if updated == nil && published == nil {
+		return true
+	}
+	return (updated != nil && updated.After(since)) ||
+		(published != nil && published.After(since))
+}
+
 func (self *Parser) entryBody(name string, entry *Entry) {
 	if self.parseEntryExt(name, entry) {
 		return
@@ -238,6 +332,7 @@ func (self *Parser) entryBody(name string, entry *Entry) {
 
 	switch name {
 	case "title":
+		entry.TitleType = self.textAttributes().Type
 		entry.Title = self.text(name)
 	case "id":
 		entry.ID = self.text(name)
@@ -264,7 +359,9 @@ func (self *Parser) entryBody(name string, entry *Entry) {
 	default:
 		// For non-standard Atom entry elements, add them to extensions
 		// under a special "_custom" namespace prefix
-		if e, ok := self.parseCustomExtInto(name, entry.Extensions); ok {
+		if self.opts.UnknownElementsSeparate {
+			entry.UnknownElements = self.appendUnknown(name, entry.UnknownElements)
+		} else if e, ok := self.parseCustomExtInto(name, entry.Extensions); ok {
 			entry.Extensions = e
 		}
 	}
@@ -423,6 +520,8 @@ func ParseLink(name string, p *xml.Parser) (*Link, error) {
 				l.Title = value
 			case "rel":
 				l.Rel = value
+			case "count":
+				l.Count = value
 			}
 		}
 		return nil
@@ -567,7 +666,7 @@ func (self *Parser) xmlContent() (string, error) {
 	}
 
 	if err := self.p.DecodeElement(&xmlContent); err != nil {
-		return "", fmt.Errorf("gofeed/atom: extract xhtml text from %q: %w",
+		return "", fmt.Errorf("gofeed/atom: extract xml text from %q: %w",
 			self.p.Name, err)
 	}
 	return strings.TrimSpace(xmlContent.InnerXML), nil
@@ -582,9 +681,23 @@ func (self *Parser) version() string {
 		return ver
 	}
 
-	if ns := self.p.Attribute("xmlns"); ns == "http://purl.org/atom/ns#" {
+	return namespaceVersion(self.p.Attribute("xmlns"))
+}
+
+// namespaceVersion returns version of Atom by its namespace ns. It tolerates
+// https scheme and trailing slash, like "https://www.w3.org/2005/Atom/".
+func namespaceVersion(ns string) string {
+	ns = strings.TrimSuffix(strings.TrimSpace(ns), "/")
+	if s, ok := strings.CutPrefix(ns, "https://"); ok {
+		ns = s
+	} else {
+		ns = strings.TrimPrefix(ns, "http://")
+	}
+
+	switch {
+	case strings.EqualFold(ns, "purl.org/atom/ns#"):
 		return "0.3"
-	} else if ns == "http://www.w3.org/2005/Atom" {
+	case strings.EqualFold(ns, "www.w3.org/2005/Atom"):
 		return "1.0"
 	}
 	return ""
@@ -612,11 +725,42 @@ func (self *Parser) parseDate(name string) (string, *time.Time) {
 
 func (self *Parser) parseCustomExtInto(name string, extensions ext.Extensions,
 ) (ext.Extensions, bool) {
-	if self.opts.SkipUnknownElements {
+	if self.opts.SkipCustomExtensions {
 		self.p.Skip(name)
 		return extensions, false
 	}
 
+	custom, ok := self.customElement(name)
+	if !ok {
+		return extensions, false
+	}
+
+	// Initialize extensions map if needed
+	const customKey = "_custom"
+	if extensions == nil {
+		extensions = ext.Extensions{customKey: {self.p.Name: {custom}}}
+	} else if m, ok := extensions[customKey]; !ok {
+		extensions[customKey] = map[string][]ext.Extension{self.p.Name: {custom}}
+	} else {
+		m[self.p.Name] = append(m[self.p.Name], custom)
+	}
+	return extensions, true
+}
+
+func (self *Parser) appendUnknown(name string, unknown []ext.Extension,
+) []ext.Extension {
+	if custom, ok := self.customElement(name); ok {
+		return append(unknown, custom)
+	}
+	return unknown
+}
+
+func (self *Parser) customElement(name string) (ext.Extension, bool) {
+	if self.opts.SkipUnknownElements {
+		self.p.Skip(name)
+		return ext.Extension{}, false
+	}
+
 	custom := ext.Extension{Name: self.p.Name, Attrs: emptyAttrs}
 	// Copy attributes
 	if n := len(self.p.Attrs); n != 0 {
@@ -624,24 +768,13 @@ func (self *Parser) parseCustomExtInto(name string, extensions ext.Extensions,
 		maps.Insert(custom.Attrs, self.p.AttributeSeq())
 	}
 
-	// Parse the text content
 	err := self.p.WithText(name, nil, func(s string) error {
 		custom.Value = s
 		return nil
 	})
 	if err != nil {
 		self.err = err
-		return extensions, false
+		return custom, false
 	}
-
-	// Initialize extensions map if needed
-	const customKey = "_custom"
-	if extensions == nil {
-		extensions = ext.Extensions{customKey: {self.p.Name: {custom}}}
-	} else if m, ok := extensions[customKey]; !ok {
-		extensions[customKey] = map[string][]ext.Extension{self.p.Name: {custom}}
-	} else {
-		m[self.p.Name] = append(m[self.p.Name], custom)
-	}
-	return extensions, true
+	return custom, true
 }
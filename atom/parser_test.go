@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -98,3 +99,94 @@ func TestParser_Parse_withSkipUnknownElements(t *testing.T) {
 			return atom.NewParser().Parse(r, options.WithSkipUnknownElements(true))
 		})
 }
+
+func TestParser_Parse_withUnknownElementsSeparate(t *testing.T) {
+	processTestFiles(t, "testdata/unknown_elements_separate",
+		func(r io.Reader) (*atom.Feed, error) {
+			return atom.NewParser().Parse(r,
+				options.WithUnknownElementsSeparate(true))
+		})
+}
+
+func TestParser_Parse_withItemsSince(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	processTestFiles(t, "testdata/items_since",
+		func(r io.Reader) (*atom.Feed, error) {
+			return atom.NewParser().Parse(r, options.WithItemsSince(since))
+		})
+}
+
+func TestParser_Parse_lenient(t *testing.T) {
+	processTestFiles(t, "testdata/lenient",
+		func(r io.Reader) (*atom.Feed, error) {
+			return atom.NewParser().Parse(r, options.WithLenient(true))
+		})
+
+	b, err := os.ReadFile("testdata/lenient/atom10_feed_entry_without_id.xml")
+	require.NoError(t, err)
+
+	p := atom.NewParser()
+	feed, err := p.Parse(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Len(t, feed.Entries, 3)
+	assert.Empty(t, feed.Entries[0].ID)
+	assert.Empty(t, p.Warnings())
+
+	feed, err = p.Parse(bytes.NewReader(b), options.WithLenient(true))
+	require.NoError(t, err)
+	assert.Len(t, p.Warnings(), 2)
+
+	feed2, err := atom.NewParser().Parse(bytes.NewReader(b),
+		options.WithLenient(true))
+	require.NoError(t, err)
+	for i, entry := range feed.Entries {
+		assert.NotEmpty(t, entry.ID)
+		assert.Equal(t, entry.ID, feed2.Entries[i].ID)
+	}
+}
+
+func TestEntry_TitleText(t *testing.T) {
+	f, err := os.Open("testdata/atom10_feed_entry_title_html_entities.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := atom.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Entries, 1)
+	entry := feed.Entries[0]
+	assert.True(t, entry.TitleHTML())
+	assert.Equal(t, "AT&amp;T <b>earnings</b> &mdash; Q1", entry.Title)
+	assert.Equal(t, "AT&T earnings — Q1", entry.TitleText())
+
+	entry = &atom.Entry{Title: "AT&amp;T", TitleType: "text"}
+	assert.False(t, entry.TitleHTML())
+	assert.Equal(t, "AT&amp;T", entry.TitleText())
+
+	feed = &atom.Feed{Title: "<p>Feed Title</p>", TitleType: "xhtml"}
+	assert.True(t, feed.TitleHTML())
+	assert.Equal(t, "Feed Title", feed.TitleText())
+}
+
+func TestFeed_PrimaryAuthor(t *testing.T) {
+	feed, err := atom.NewParser().Parse(strings.NewReader(`<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Co-hosted</title>
+  <generator uri="https://host.example.com/">Host</generator>
+  <author><name>First</name><uri>https://first.example.com/</uri></author>
+  <author><name>Owner</name><email>owner@host.example.com</email></author>
+  <author><name>Third</name></author>
+</feed>`))
+	require.NoError(t, err)
+	require.Len(t, feed.Authors, 3)
+	require.NotNil(t, feed.PrimaryAuthor())
+	assert.Equal(t, "Owner", feed.PrimaryAuthor().Name)
+	assert.Equal(t, "First", feed.GetAuthor().Name)
+
+	feed.Generator.URI = "https://other.example.com/"
+	assert.Equal(t, "First", feed.PrimaryAuthor().Name)
+
+	feed.Generator = nil
+	assert.Equal(t, "First", feed.PrimaryAuthor().Name)
+
+	feed.Authors = nil
+	assert.Nil(t, feed.PrimaryAuthor())
+}
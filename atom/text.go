@@ -11,6 +11,11 @@ func (self *textAttributes) XHTML() bool {
 	return self.Type == "xhtml" || strings.Contains(self.Type, "/xhtml")
 }
 
+// HTML returns true if the text contains HTML or XHTML markup.
+func (self *textAttributes) HTML() bool {
+	return self.Type == "html" || self.Type == "text/html" || self.XHTML()
+}
+
 func (self *textAttributes) XML() bool {
 	return self.Mode == "xml" || strings.HasSuffix(self.Type, "+xml") ||
 		strings.HasSuffix(self.Type, "/xml")
@@ -0,0 +1,68 @@
+package gofeed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// contextReader is an [io.Reader], which stops reading after its context is
+// done, even if a read from underlying reader is blocked.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+
+	buf     []byte
+	results chan readResult
+}
+
+// readResult is the result of a read from underlying reader of
+// [contextReader].
+type readResult struct {
+	n   int
+	err error
+}
+
+// withContext returns r, which stops reading after ctx is done. It returns r
+// as is, if ctx is never done, or r is in memory already and never blocks, so
+// checks of ctx by the feed parsers are enough.
+func withContext(ctx context.Context, r io.Reader) io.Reader {
+	if ctx.Done() == nil {
+		return r
+	}
+
+	switch r.(type) {
+	case *bytes.Reader, *strings.Reader, *bytes.Buffer:
+		return r
+	}
+	return &contextReader{ctx: ctx, r: r, results: make(chan readResult, 1)}
+}
+
+// Read reads from underlying reader in a goroutine and waits for the result or
+// for ctx is done. The goroutine reads into own buffer of the reader, so a read,
+// which is still blocked after ctx is done, never writes into p. Such read ends
+// in the background, when underlying reader returns, like after closing of the
+// response body.
+func (self *contextReader) Read(p []byte) (int, error) {
+	if err := self.ctx.Err(); err != nil {
+		return 0, fmt.Errorf("gofeed: stop reading: %w", err)
+	}
+
+	if cap(self.buf) < len(p) {
+		self.buf = make([]byte, len(p))
+	}
+	buf := self.buf[:len(p)]
+	go func() {
+		n, err := self.r.Read(buf)
+		self.results <- readResult{n: n, err: err}
+	}()
+
+	select {
+	case res := <-self.results:
+		return copy(p, buf[:res.n]), res.err
+	case <-self.ctx.Done():
+		return 0, fmt.Errorf("gofeed: stop reading: %w", self.ctx.Err())
+	}
+}
@@ -0,0 +1,79 @@
+package gofeed
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// charRefRgx matches numeric character references, like "&#12;" or "&#x0C;".
+var charRefRgx = regexp.MustCompile(`&#(?:[xX]([0-9a-fA-F]+)|([0-9]+));`)
+
+// stripControlChars removes control characters and numeric character
+// references to them from text fields of feed and its items.
+func stripControlChars(feed *Feed) {
+	for _, s := range []*string{
+		&feed.Title, &feed.Description, &feed.Copyright,
+	} {
+		*s = stripControl(*s)
+	}
+	stripPersons(feed.Author)
+	stripPersons(feed.Authors...)
+
+	for _, item := range feed.Items {
+		for _, s := range []*string{
+			&item.Title, &item.Description, &item.Content,
+		} {
+			*s = stripControl(*s)
+		}
+		stripPersons(item.Author)
+		stripPersons(item.Authors...)
+		for i, c := range item.Categories {
+			item.Categories[i] = stripControl(c)
+		}
+	}
+}
+
+func stripPersons(persons ...*Person) {
+	for _, p := range persons {
+		if p != nil {
+			p.Name = stripControl(p.Name)
+		}
+	}
+}
+
+// stripControl removes control characters, except tab, newline and carriage
+// return, and numeric character references to them from s.
+func stripControl(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if isStrippedControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	if !strings.Contains(s, "&#") {
+		return s
+	}
+
+	return charRefRgx.ReplaceAllStringFunc(s, func(ref string) string {
+		m := charRefRgx.FindStringSubmatch(ref)
+		n, err := strconv.ParseInt(m[2], 10, 32)
+		if m[1] != "" {
+			n, err = strconv.ParseInt(m[1], 16, 32)
+		}
+		if err == nil && isStrippedControl(rune(n)) {
+			return ""
+		}
+		return ref
+	})
+}
+
+func isStrippedControl(r rune) bool {
+	switch r {
+	case '\t', '\n', '\r':
+		return false
+	}
+	return unicode.IsControl(r)
+}
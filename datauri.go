@@ -0,0 +1,56 @@
+package gofeed
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/dsh2dsh/gofeed/v2/options"
+)
+
+// ParseDataURI parses a feed, embedded into data: URI, like
+//
+//	data:application/rss+xml;base64,PHJzcyB2ZXJzaW9uPSIyLjAiPi4uLjwvcnNzPg==
+//	data:application/rss+xml;charset=utf-8,%3Crss%20version%3D%222.0%22%3E...
+//
+// into the universal gofeed.Feed. Payload of the URI can be base64 or
+// percent-encoded. It returns [ErrInvalidDataURI] if the URI is malformed.
+func ParseDataURI(uri string, opts ...options.Option) (*Feed, error) {
+	b, err := decodeDataURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	return NewParser(opts...).Parse(bytes.NewReader(b))
+}
+
+// decodeDataURI returns payload of data: URI.
+func decodeDataURI(uri string) ([]byte, error) {
+	const scheme = "data:"
+	uri = strings.TrimSpace(uri)
+	if len(uri) < len(scheme) || !strings.EqualFold(uri[:len(scheme)], scheme) {
+		return nil, fmt.Errorf("%w: missing %q scheme", ErrInvalidDataURI, scheme)
+	}
+
+	header, payload, ok := strings.Cut(uri[len(scheme):], ",")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing comma before data", ErrInvalidDataURI)
+	}
+
+	data, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDataURI, err)
+	}
+
+	if !strings.EqualFold(header, "base64") &&
+		!strings.HasSuffix(strings.ToLower(header), ";base64") {
+		return []byte(data), nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode base64: %w", ErrInvalidDataURI, err)
+	}
+	return b, nil
+}
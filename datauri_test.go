@@ -0,0 +1,71 @@
+package gofeed_test
+
+import (
+	"encoding/base64"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dsh2dsh/gofeed/v2"
+)
+
+func TestParseDataURI(t *testing.T) {
+	const feedData = `<rss version="2.0"><channel>
+<title>Feed Title</title>
+<item><title>Item Title</title></item>
+</channel></rss>`
+
+	base64Data := base64.StdEncoding.EncodeToString([]byte(feedData))
+	tests := []struct {
+		name string
+		uri  string
+	}{
+		{"base64", "data:application/rss+xml;base64," + base64Data},
+		{"base64 without media type", "data:;base64," + base64Data},
+		{
+			"base64 with charset",
+			"data:application/rss+xml;charset=utf-8;base64," + base64Data,
+		},
+		{
+			"percent-encoded",
+			"data:application/rss+xml;charset=utf-8," + url.PathEscape(feedData),
+		},
+		{"uppercase scheme", "DATA:;BASE64," + base64Data},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.ParseDataURI(tt.uri)
+			require.NoError(t, err)
+			assert.Equal(t, "rss", feed.FeedType)
+			assert.Equal(t, "Feed Title", feed.Title)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, "Item Title", feed.Items[0].Title)
+		})
+	}
+}
+
+func TestParseDataURI_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+	}{
+		{"not data", "http://example.org/feed.xml"},
+		{"without comma", "data:application/rss+xml;base64"},
+		{"invalid base64", "data:;base64,!!!"},
+		{"invalid percent-encoding", "data:text/xml,%zz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.ParseDataURI(tt.uri)
+			require.ErrorIs(t, err, gofeed.ErrInvalidDataURI)
+			assert.Nil(t, feed)
+		})
+	}
+
+	_, err := gofeed.ParseDataURI("data:text/plain,not a feed")
+	require.ErrorIs(t, err, gofeed.ErrFeedTypeNotDetected)
+}
@@ -1,13 +1,16 @@
 package gofeed
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"strings"
 	"unicode"
 
 	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+	"github.com/dsh2dsh/gofeed/v2/options"
 )
 
 // FeedType represents one of the possible feed
@@ -26,21 +29,82 @@ const (
 	FeedTypeJSON
 )
 
+// detectEmbeddedLimit is how many bytes of HTML page are scanned for embedded
+// feed with [options.WithDetectEmbedded].
+const detectEmbeddedLimit = 64 << 10
+
+// detectPeekSize is how many bytes of the feed are read at first for detection
+// of its type with [options.WithStreaming]. It's doubled until the type is
+// detected or [detectEmbeddedLimit] reached.
+const detectPeekSize = 512
+
+// embeddedRoots maps lowercased root elements of feeds to their types.
+var embeddedRoots = map[string]FeedType{
+	"rss":     FeedTypeRSS,
+	"rdf:rdf": FeedTypeRSS,
+	"feed":    FeedTypeAtom,
+}
+
 // DetectFeedType attempts to determine the type of feed by looking for specific
 // xml elements, unique to the various feed types. It returns FeedTypeUnknown
 // when the reader fails before the type can be determined.
-func DetectFeedType(feed io.Reader) FeedType {
+func DetectFeedType(feed io.Reader, opts ...options.Option) FeedType {
 	var buffer bytes.Buffer
 	if _, err := buffer.ReadFrom(feed); err != nil {
 		return FeedTypeUnknown
 	}
-	return DetectFeedBytes(buffer.Bytes())
+	return DetectFeedBytes(buffer.Bytes(), opts...)
 }
 
 // DetectFeedBytes attempts to determine the type of feed by looking for
 // specific xml elements, unique to the various feed types.
-func DetectFeedBytes(b []byte) FeedType {
+func DetectFeedBytes(b []byte, opts ...options.Option) FeedType {
+	var parseOpts options.Parse
+	parseOpts.Apply(opts...)
+	feedType, _ := detectFeed(b, &parseOpts)
+	return feedType
+}
+
+// detectFeed returns type of the feed and its bytes. The bytes are different
+// from b only if the feed is embedded into HTML page.
+func detectFeed(b []byte, opts *options.Parse) (FeedType, []byte) {
+	feedType, offset, _ := detectPrefix(b, false, true, opts)
+	return feedType, b[offset:]
+}
+
+// detectFeedReader detects type of the feed by the beginning of r, without
+// reading the whole feed. It returns type of the feed and a reader of the feed,
+// which starts from its beginning, or from found tag, if the feed is embedded
+// into HTML page. Validity of JSON feeds isn't checked.
+func detectFeedReader(r io.Reader, opts *options.Parse,
+) (FeedType, io.Reader, error) {
+	br := bufio.NewReaderSize(r, detectEmbeddedLimit)
+	for n := detectPeekSize; ; n = min(2*n, detectEmbeddedLimit) {
+		b, err := br.Peek(n)
+		more := err == nil && n < detectEmbeddedLimit
+		feedType, offset, ok := detectPrefix(b, more, false, opts)
+		if !ok {
+			continue
+		} else if feedType == FeedTypeUnknown && err != nil &&
+			!errors.Is(err, io.EOF) {
+			return feedType, nil, err
+		}
+
+		// Read error, if any, will be returned again to the feed parser.
+		if _, err := br.Discard(offset); err != nil {
+			return FeedTypeUnknown, nil, err
+		}
+		return feedType, br, nil
+	}
+}
+
+// detectPrefix returns type of the feed by its beginning b and offset of the
+// feed in b. It returns false, if more bytes are needed and more is true.
+// validJSON defines whether b must be a valid JSON document for JSON feed.
+func detectPrefix(b []byte, more, validJSON bool, opts *options.Parse,
+) (feedType FeedType, offset int, ok bool) {
 	var firstChar byte
+	start := b
 loop:
 	for i, ch := range b {
 		// ignore leading whitespace & byte order marks
@@ -52,31 +116,93 @@ loop:
 		case 0xFE, 0xFF, 0x00, 0xEF, 0xBB, 0xBF: // utf 8-16-32 bom
 		default:
 			firstChar = ch
-			b = b[i:]
+			start = b[i:]
 			break loop
 		}
 	}
 
 	switch firstChar {
+	case 0:
+		return FeedTypeUnknown, 0, !more
 	case '<':
-		// Check if it's an XML based feed
-		p := xml.NewParser(bytes.NewReader(b))
+		// Check if it's an XML based feed. Charset options are needed to read
+		// feeds with unsupported declared charset, like the feed parser does.
+		p := xml.NewParser(bytes.NewReader(start),
+			options.WithCharsetReader(opts.CharsetReader),
+			options.WithCharsetFallback(opts.CharsetFallback || opts.Lenient))
 
 		if _, err := p.FindRoot(); err != nil {
-			return FeedTypeUnknown
+			return FeedTypeUnknown, 0, !more
 		}
 
 		switch strings.ToLower(p.Name) {
 		case "rdf", "rss":
-			return FeedTypeRSS
+			return FeedTypeRSS, 0, true
 		case "feed":
-			return FeedTypeAtom
+			return FeedTypeAtom, 0, true
+		case "html":
+			if opts.DetectEmbedded {
+				feedType, i := detectEmbedded(start)
+				if feedType == FeedTypeUnknown {
+					return feedType, 0, !more
+				}
+				return feedType, len(b) - len(start) + i, true
+			}
 		}
 	case '{':
 		// Check if document is valid JSON
-		if json.Valid(b) {
-			return FeedTypeJSON
+		if !validJSON || json.Valid(start) {
+			return FeedTypeJSON, 0, true
 		}
 	}
-	return FeedTypeUnknown
+	return FeedTypeUnknown, 0, true
+}
+
+// detectEmbedded looks for start tag of RSS or Atom feed in the first
+// [detectEmbeddedLimit] bytes of HTML page b. It returns type of the feed and
+// offset of found tag in b.
+func detectEmbedded(b []byte) (FeedType, int) {
+	// Names are matched case-insensitively on original bytes, because
+	// bytes.ToLower can change length of non-ASCII text and offsets of tags.
+	head := b[:min(len(b), detectEmbeddedLimit)]
+	for i := 0; i < len(head); i++ {
+		j := bytes.IndexByte(head[i:], '<')
+		if j < 0 {
+			break
+		}
+		i += j
+
+		tag := head[i+1:]
+		for name, feedType := range embeddedRoots {
+			if embeddedTag(tag, name) {
+				return feedType, i
+			}
+		}
+	}
+	return FeedTypeUnknown, 0
+}
+
+// embeddedTag returns true if tag starts with lowercase ASCII name, ignoring
+// case, followed by whitespace, '>' or '/'.
+func embeddedTag(tag []byte, name string) bool {
+	if len(tag) <= len(name) {
+		return false
+	}
+
+	for i := range len(name) {
+		ch := tag[i]
+		if 'A' <= ch && ch <= 'Z' {
+			ch += 'a' - 'A'
+		}
+		if ch != name[i] {
+			return false
+		}
+	}
+
+	switch ch := tag[len(name)]; ch {
+	case '>', '/':
+		return true
+	default:
+		return unicode.IsSpace(rune(ch))
+	}
 }
@@ -11,8 +11,10 @@ import (
 	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/dsh2dsh/gofeed/v2"
+	"github.com/dsh2dsh/gofeed/v2/options"
 )
 
 func TestDetectFeedType(t *testing.T) {
@@ -70,3 +72,50 @@ func TestDetectFeedType_ReaderError(t *testing.T) {
 		iotest.ErrReader(errors.New("boom")))
 	assert.Equal(t, gofeed.FeedTypeUnknown, gofeed.DetectFeedType(r))
 }
+
+func TestDetectFeedType_embedded(t *testing.T) {
+	b, err := os.ReadFile("testdata/parser/html_embedded_rss.html")
+	require.NoError(t, err)
+
+	assert.Equal(t, gofeed.FeedTypeUnknown, gofeed.DetectFeedBytes(b))
+	assert.Equal(t, gofeed.FeedTypeRSS,
+		gofeed.DetectFeedBytes(b, options.WithDetectEmbedded(true)))
+	assert.Equal(t, gofeed.FeedTypeAtom,
+		gofeed.DetectFeedBytes([]byte(`<html><body><feed
+xmlns="http://www.w3.org/2005/Atom"></feed></body></html>`),
+			options.WithDetectEmbedded(true)))
+	assert.Equal(t, gofeed.FeedTypeUnknown,
+		gofeed.DetectFeedBytes([]byte(`<html><body><feeds></feeds></body></html>`),
+			options.WithDetectEmbedded(true)))
+
+	feed, err := gofeed.NewParser(options.WithDetectEmbedded(true)).Parse(
+		bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Equal(t, "Embedded Feed", feed.Title)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "Embedded Item", feed.Items[0].Title)
+}
+
+// Non-ASCII text, which changes length when lowercased, must not shift offset
+// of embedded feed.
+func TestDetectFeedType_embeddedNonASCII(t *testing.T) {
+	assert.Equal(t, gofeed.FeedTypeUnknown,
+		gofeed.DetectFeedBytes([]byte(strings.Repeat("Ⱥ", 200)+"<rss>"),
+			options.WithDetectEmbedded(true)))
+
+	b := []byte("<html><body>" + strings.Repeat("Ⱥ", 20) +
+		`<rss version="2.0"><channel><title>T</title></channel></rss>` +
+		"</body></html>")
+	assert.Equal(t, gofeed.FeedTypeRSS,
+		gofeed.DetectFeedBytes(b, options.WithDetectEmbedded(true)))
+
+	for _, streaming := range []bool{false, true} {
+		t.Run(fmt.Sprintf("streaming=%v", streaming), func(t *testing.T) {
+			fp := gofeed.NewParser(options.WithDetectEmbedded(true),
+				options.WithStreaming(streaming))
+			feed, err := fp.Parse(bytes.NewReader(b))
+			require.NoError(t, err)
+			assert.Equal(t, "T", feed.Title)
+		})
+	}
+}
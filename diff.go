@@ -0,0 +1,87 @@
+package gofeed
+
+import "time"
+
+// FeedDiff is a difference between two parsed versions of the same feed,
+// returned by [Diff].
+type FeedDiff struct {
+	// Added are items of the new feed, which the old feed doesn't have.
+	Added []*Item
+	// Removed are items of the old feed, which the new feed doesn't have.
+	Removed []*Item
+	// Updated are items of the new feed with changed title, content or update
+	// date.
+	Updated []*Item
+}
+
+// Empty returns true if there is no difference between feeds.
+func (d FeedDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Updated) == 0
+}
+
+// Diff compares items of old and new versions of the same feed. Items are
+// matched by GUID, or by Link if GUID is empty. Items without GUID and Link
+// can't be matched, so they are always reported as added or removed. Any of
+// feeds can be nil, which is the same as the feed without items.
+func Diff(oldFeed, newFeed *Feed) FeedDiff {
+	oldItems := make(map[string]*Item)
+	for _, item := range itemsOf(oldFeed) {
+		if key := item.diffKey(); key != "" {
+			oldItems[key] = item
+		}
+	}
+
+	var diff FeedDiff
+	seen := make(map[string]struct{}, len(oldItems))
+	for _, item := range itemsOf(newFeed) {
+		key := item.diffKey()
+		oldItem, ok := oldItems[key]
+		if !ok {
+			diff.Added = append(diff.Added, item)
+			continue
+		}
+
+		seen[key] = struct{}{}
+		if item.changedFrom(oldItem) {
+			diff.Updated = append(diff.Updated, item)
+		}
+	}
+
+	for _, item := range itemsOf(oldFeed) {
+		if _, ok := seen[item.diffKey()]; !ok {
+			diff.Removed = append(diff.Removed, item)
+		}
+	}
+	return diff
+}
+
+func itemsOf(f *Feed) []*Item {
+	if f == nil {
+		return nil
+	}
+	return f.Items
+}
+
+// diffKey returns identity of the item, used by [Diff].
+func (i *Item) diffKey() string {
+	if i.GUID != "" {
+		return "guid:" + i.GUID
+	} else if i.Link != "" {
+		return "link:" + i.Link
+	}
+	return ""
+}
+
+// changedFrom returns true if title, content or update date of the item differ
+// from old.
+func (i *Item) changedFrom(old *Item) bool {
+	return i.Title != old.Title || i.Content != old.Content ||
+		!equalTime(i.UpdatedParsed, old.UpdatedParsed)
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,69 @@
+package gofeed_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dsh2dsh/gofeed/v2"
+)
+
+func TestDiff(t *testing.T) {
+	updated := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	later := updated.Add(time.Hour)
+
+	oldFeed := &gofeed.Feed{Items: []*gofeed.Item{
+		{GUID: "same", Title: "Same", Content: "content"},
+		{GUID: "removed", Title: "Removed"},
+		{GUID: "title", Title: "Old title"},
+		{Link: "http://example.org/content", Content: "old content"},
+		{GUID: "date", UpdatedParsed: &updated},
+		{GUID: "sameDate", UpdatedParsed: &updated},
+		{Title: "Keyless"},
+	}}
+
+	sameDate := updated.In(time.FixedZone("", 3600))
+	newFeed := &gofeed.Feed{Items: []*gofeed.Item{
+		{GUID: "added", Title: "Added"},
+		{GUID: "same", Title: "Same", Content: "content"},
+		{GUID: "title", Title: "New title"},
+		{Link: "http://example.org/content", Content: "new content"},
+		{GUID: "date", UpdatedParsed: &later},
+		{GUID: "sameDate", UpdatedParsed: &sameDate},
+		{Title: "Keyless"},
+	}}
+
+	diff := gofeed.Diff(oldFeed, newFeed)
+	assert.Equal(t, []*gofeed.Item{newFeed.Items[0], newFeed.Items[6]},
+		diff.Added)
+	assert.Equal(t, []*gofeed.Item{oldFeed.Items[1], oldFeed.Items[6]},
+		diff.Removed)
+	assert.Equal(t, newFeed.Items[2:5], diff.Updated)
+	assert.False(t, diff.Empty())
+}
+
+func TestDiff_noChanges(t *testing.T) {
+	feed := &gofeed.Feed{Items: []*gofeed.Item{
+		{GUID: "1", Title: "First"},
+		{Link: "http://example.org/2", Title: "Second"},
+	}}
+
+	diff := gofeed.Diff(feed, feed)
+	assert.True(t, diff.Empty())
+	assert.Equal(t, gofeed.FeedDiff{}, diff)
+}
+
+func TestDiff_nilFeeds(t *testing.T) {
+	feed := &gofeed.Feed{Items: []*gofeed.Item{{GUID: "1"}}}
+
+	diff := gofeed.Diff(nil, feed)
+	assert.Equal(t, feed.Items, diff.Added)
+	assert.Empty(t, diff.Removed)
+
+	diff = gofeed.Diff(feed, nil)
+	assert.Equal(t, feed.Items, diff.Removed)
+	assert.Empty(t, diff.Added)
+
+	assert.True(t, gofeed.Diff(nil, nil).Empty())
+}
@@ -0,0 +1,9 @@
+package ext
+
+// CompanyExtension represents a feed extension for the company module
+// (http://purl.org/rss/1.0/modules/company).
+type CompanyExtension struct {
+	Names     []string `json:"name,omitempty"`
+	Tickers   []string `json:"ticker,omitempty"`
+	Exchanges []string `json:"exchange,omitempty"`
+}
@@ -0,0 +1,15 @@
+package ext
+
+// EmailExtension represents a feed extension for the email module
+// (http://purl.org/rss/1.0/modules/email/), used by email-to-RSS gateways.
+type EmailExtension struct {
+	From    EmailAddress   `json:"from,omitzero"`
+	To      []EmailAddress `json:"to,omitempty"`
+	ReplyTo EmailAddress   `json:"replyTo,omitzero"`
+}
+
+// EmailAddress is a name and an address, like "John Doe <john@example.org>".
+type EmailAddress struct {
+	Name    string `json:"name,omitempty"`
+	Address string `json:"address,omitempty"`
+}
@@ -0,0 +1,26 @@
+package ext
+
+import "strings"
+
+// FOAFExtension represents people, described by the FOAF vocabulary
+// (http://xmlns.com/foaf/0.1/), like authors of RSS 1.0 feeds.
+type FOAFExtension struct {
+	Persons []FOAFPerson `json:"persons,omitempty"`
+}
+
+// FOAFPerson is a <foaf:Person>.
+type FOAFPerson struct {
+	Name     string `json:"name,omitempty"`
+	Mbox     string `json:"mbox,omitempty"`
+	Homepage string `json:"homepage,omitempty"`
+}
+
+// Email returns email address from Mbox, without "mailto:" scheme.
+func (self *FOAFPerson) Email() string {
+	const mailto = "mailto:"
+	s := strings.TrimSpace(self.Mbox)
+	if len(s) > len(mailto) && strings.EqualFold(s[:len(mailto)], mailto) {
+		return s[len(mailto):]
+	}
+	return s
+}
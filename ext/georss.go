@@ -0,0 +1,23 @@
+package ext
+
+// GeoExtension represents a feed extension for the GeoRSS Simple module
+// (http://www.georss.org/georss), which describes location of the item.
+type GeoExtension struct {
+	Point           string   `json:"point,omitempty"`
+	Box             string   `json:"box,omitempty"`
+	FeatureTypeTag  string   `json:"featureTypeTag,omitempty"`
+	FeatureName     string   `json:"featureName,omitempty"`
+	RelationshipTag string   `json:"relationshipTag,omitempty"`
+	Elev            *float64 `json:"elev,omitempty"`
+	Floor           string   `json:"floor,omitempty"`
+	Radius          *float64 `json:"radius,omitempty"`
+}
+
+// Elevation returns georss:elev in meters and true, or false if the extension
+// has no valid elevation.
+func (self *GeoExtension) Elevation() (float64, bool) {
+	if self == nil || self.Elev == nil {
+		return 0, false
+	}
+	return *self.Elev, true
+}
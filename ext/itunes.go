@@ -1,5 +1,14 @@
 package ext
 
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dsh2dsh/gofeed/v2/internal/htmltext"
+)
+
 // ITunesFeedExtension is a set of extension
 // fields for RSS feeds.
 type ITunesFeedExtension struct {
@@ -17,6 +26,22 @@ type ITunesFeedExtension struct {
 	Type       string            `json:"type,omitempty"`
 }
 
+// SummaryText returns Summary as plain text, without HTML tags and with
+// collapsed whitespace. Summary itself is kept as is.
+func (self *ITunesFeedExtension) SummaryText() string {
+	return htmltext.Strip(self.Summary)
+}
+
+// IsBlocked returns true if itunes:block is "yes", which means the podcast
+// must be hidden from directories.
+func (self *ITunesFeedExtension) IsBlocked() bool { return isYes(self.Block) }
+
+// IsComplete returns true if itunes:complete is "yes", which means the podcast
+// is finished and no more episodes will be published.
+func (self *ITunesFeedExtension) IsComplete() bool {
+	return isYes(self.Complete)
+}
+
 // ITunesItemExtension is a set of extension
 // fields for RSS items.
 type ITunesItemExtension struct {
@@ -46,3 +71,92 @@ type ITunesOwner struct {
 	Email string `json:"email,omitempty"`
 	Name  string `json:"name,omitempty"`
 }
+
+// KeywordList returns comma-separated Keywords as a list of trimmed keywords,
+// without empty and duplicate ones.
+func (self *ITunesItemExtension) KeywordList() []string {
+	if self.Keywords == "" {
+		return nil
+	}
+
+	var keywords []string
+	for s := range strings.SplitSeq(self.Keywords, ",") {
+		s = strings.TrimSpace(s)
+		if s != "" && !slices.Contains(keywords, s) {
+			keywords = append(keywords, s)
+		}
+	}
+	return keywords
+}
+
+// IsBlocked returns true if itunes:block is "yes", which means the episode
+// must be hidden from directories.
+func (self *ITunesItemExtension) IsBlocked() bool { return isYes(self.Block) }
+
+// IsExplicit returns true if itunes:explicit is "yes", "true" or "explicit",
+// and false if it's "no", "false" or "clean". The second result is false, if
+// itunes:explicit is missing or has another value.
+func (self *ITunesItemExtension) IsExplicit() (explicit, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(self.Explicit)) {
+	case "yes", "true", "explicit":
+		return true, true
+	case "no", "false", "clean":
+		return false, true
+	}
+	return false, false
+}
+
+// ParsedDuration returns Duration as [time.Duration]. Duration can be number of
+// seconds or in "HH:MM:SS" or "MM:SS" format. It returns 0 if duration is
+// missing or malformed.
+func (self *ITunesItemExtension) ParsedDuration() time.Duration {
+	s := strings.TrimSpace(self.Duration)
+	if s == "" {
+		return 0
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0
+	}
+
+	var secs float64
+	for i, part := range parts {
+		n, err := strconv.ParseFloat(part, 64)
+		// Only the last part, seconds, may have fraction.
+		if err != nil || n < 0 || (i < len(parts)-1 && n != float64(int(n))) {
+			return 0
+		}
+		secs = secs*60 + n
+	}
+	return time.Duration(secs * float64(time.Second))
+}
+
+// SeasonNumber returns Season as a number. The second result is false if the
+// season is missing or isn't a non-negative number.
+func (self *ITunesItemExtension) SeasonNumber() (int, bool) {
+	return atoi(self.Season)
+}
+
+// EpisodeNumber returns Episode as a number. The second result is false if the
+// episode is missing or isn't a non-negative number.
+func (self *ITunesItemExtension) EpisodeNumber() (int, bool) {
+	return atoi(self.Episode)
+}
+
+func atoi(s string) (int, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// isYes returns true if s is "yes" or "true", ignoring case.
+func isYes(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "yes", "true":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package ext_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+)
+
+func TestITunesItemExtension_KeywordList(t *testing.T) {
+	tests := []struct {
+		keywords string
+		expected []string
+	}{
+		{"a, b ,, c", []string{"a", "b", "c"}},
+		{"a,b,a, b", []string{"a", "b"}},
+		{" , ,", nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keywords, func(t *testing.T) {
+			itunes := ext.ITunesItemExtension{Keywords: tt.keywords}
+			assert.Equal(t, tt.expected, itunes.KeywordList())
+		})
+	}
+}
+
+func TestITunesFeedExtension_IsBlocked(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"yes", true},
+		{"Yes", true},
+		{"true", true},
+		{" YES ", true},
+		{"no", false},
+		{"false", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			feed := ext.ITunesFeedExtension{Block: tt.value, Complete: tt.value}
+			assert.Equal(t, tt.expected, feed.IsBlocked())
+			assert.Equal(t, tt.expected, feed.IsComplete())
+
+			item := ext.ITunesItemExtension{Block: tt.value}
+			assert.Equal(t, tt.expected, item.IsBlocked())
+		})
+	}
+}
+
+func TestITunesItemExtension_ParsedDuration(t *testing.T) {
+	tests := []struct {
+		duration string
+		expected time.Duration
+	}{
+		{"90", 90 * time.Second},
+		{"1:30", 90 * time.Second},
+		{"01:02:03", time.Hour + 2*time.Minute + 3*time.Second},
+		{"1:30.5", 90*time.Second + 500*time.Millisecond},
+		{" 45 ", 45 * time.Second},
+		{"1.5:30", 0},
+		{"1:2:3:4", 0},
+		{"-5", 0},
+		{"abc", 0},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.duration, func(t *testing.T) {
+			itunes := ext.ITunesItemExtension{Duration: tt.duration}
+			assert.Equal(t, tt.expected, itunes.ParsedDuration())
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package ext
 
-import "iter"
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // https://www.rssboard.org/media-rss
 type Media struct {
@@ -13,6 +19,9 @@ type Media struct {
 	Titles       []MediaDescription `json:"title,omitempty"`
 	Descriptions []MediaDescription `json:"description,omitempty"`
 	PeerLinks    []MediaPeerLink    `json:"peerLink,omitempty"`
+	Prices       []MediaPrice       `json:"price,omitempty"`
+	Ratings      []MediaRating      `json:"rating,omitempty"`
+	Tags         []MediaTag         `json:"tags,omitempty"`
 }
 
 type MediaGroup struct {
@@ -23,16 +32,24 @@ type MediaGroup struct {
 	Titles       []MediaDescription `json:"title,omitempty"`
 	Descriptions []MediaDescription `json:"description,omitempty"`
 	PeerLinks    []MediaPeerLink    `json:"peerLink,omitempty"`
+	Prices       []MediaPrice       `json:"price,omitempty"`
+	Ratings      []MediaRating      `json:"rating,omitempty"`
 	Community    MediaCommunity     `json:"community,omitzero"`
+	Tags         []MediaTag         `json:"tags,omitempty"`
 }
 
 type MediaContent struct {
-	URL      string `json:"url,omitempty"`
-	Type     string `json:"type,omitempty"`
-	FileSize string `json:"fileSize,omitempty"`
-	Medium   string `json:"medium,omitempty"`
-	Height   int    `json:"height,omitempty"`
-	Width    int    `json:"width,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Type      string `json:"type,omitempty"`
+	FileSize  string `json:"fileSize,omitempty"`
+	Medium    string `json:"medium,omitempty"`
+	Height    int    `json:"height,omitempty"`
+	Width     int    `json:"width,omitempty"`
+	Duration  string `json:"duration,omitempty"`
+	IsDefault bool   `json:"isDefault,omitempty"`
+
+	Lang       string `json:"lang,omitempty"`
+	Expression string `json:"expression,omitempty"`
 
 	Categories   []string           `json:"category,omitempty"`
 	Thumbnails   []string           `json:"thumbnail,omitempty"`
@@ -40,6 +57,28 @@ type MediaContent struct {
 	Titles       []MediaDescription `json:"title,omitempty"`
 	Descriptions []MediaDescription `json:"description,omitempty"`
 	PeerLinks    []MediaPeerLink    `json:"peerLink,omitempty"`
+	Prices       []MediaPrice       `json:"price,omitempty"`
+	Ratings      []MediaRating      `json:"rating,omitempty"`
+}
+
+// ParsedDuration returns Duration as [time.Duration]. It returns 0 if duration
+// is missing or isn't a number of seconds.
+func (self *MediaContent) ParsedDuration() time.Duration {
+	secs, err := strconv.ParseFloat(strings.TrimSpace(self.Duration), 64)
+	if err != nil || secs <= 0 {
+		return 0
+	}
+	return time.Duration(secs * float64(time.Second))
+}
+
+// FileSizeBytes returns FileSize as number of bytes. It returns 0 if file size
+// is missing or malformed.
+func (self *MediaContent) FileSizeBytes() int64 {
+	n, err := strconv.ParseInt(strings.TrimSpace(self.FileSize), 10, 64)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
 }
 
 type MediaThumbnail struct {
@@ -58,6 +97,36 @@ type MediaPeerLink struct {
 	Type string `json:"type,omitempty"`
 }
 
+// MediaPrice is a price of paid media content, like rental or subscription.
+type MediaPrice struct {
+	Type     string  `json:"type,omitempty"`
+	Price    float64 `json:"price,omitempty"`
+	Currency string  `json:"currency,omitempty"`
+	Info     string  `json:"info,omitempty"`
+}
+
+// Paid returns true if type of the price is one of paid types of Media RSS:
+// "rent", "purchase", "package" or "subscription".
+func (self *MediaPrice) Paid() bool {
+	switch strings.ToLower(self.Type) {
+	case "rent", "purchase", "package", "subscription":
+		return true
+	}
+	return false
+}
+
+// MediaRating is a <media:rating>, like "adult" of "urn:simple" scheme.
+type MediaRating struct {
+	Scheme string `json:"scheme,omitempty"`
+	Value  string `json:"value,omitempty"`
+}
+
+// MediaTag is a weighted folksonomy tag from <media:tags>, like "news: 5".
+type MediaTag struct {
+	Name   string `json:"name,omitempty"`
+	Weight int    `json:"weight,omitempty"`
+}
+
 type MediaCommunity struct {
 	StarRating MediaStarRating `json:"starRating,omitzero"`
 	Statistics MediaStatistics `json:"statistics,omitzero"`
@@ -103,6 +172,39 @@ func (self *Media) categoriesIter(yield func(string) bool) {
 	}
 }
 
+// AllTags iterates over tags of media and its groups.
+func (self *Media) AllTags() iter.Seq[MediaTag] {
+	return self.tagsIter
+}
+
+func (self *Media) tagsIter(yield func(MediaTag) bool) {
+	for _, t := range self.Tags {
+		if !yield(t) {
+			return
+		}
+	}
+
+	for _, g := range self.Groups {
+		for _, t := range g.Tags {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Duration returns duration of the first media content with valid duration
+// attribute, which is number of seconds. It returns 0 if there is no such
+// content.
+func (self *Media) Duration() time.Duration {
+	for c := range self.AllContents() {
+		if d := c.ParsedDuration(); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
 func (self *Media) AllContents() iter.Seq[MediaContent] {
 	return self.contentsIter
 }
@@ -191,6 +293,147 @@ func (self *Media) thumbnailsExIter(yield func(MediaThumbnail) bool) {
 	}
 }
 
+// BestThumbnail returns URL of the smallest thumbnail, which is not narrower
+// than targetWidth. If all thumbnails are narrower, it returns the widest one.
+// It returns empty string if media has no thumbnails.
+func (self *Media) BestThumbnail(targetWidth int) string {
+	var best, widest *MediaThumbnail
+	for t := range self.AllThumbnailsEx() {
+		if widest == nil || t.Width > widest.Width {
+			widest = &t
+		}
+		if t.Width >= targetWidth && (best == nil || t.Width < best.Width) {
+			best = &t
+		}
+	}
+
+	switch {
+	case best != nil:
+		return best.URL
+	case widest != nil:
+		return widest.URL
+	}
+	return ""
+}
+
+// Adult returns true if media is rated "adult" by "urn:simple" scheme, false
+// if it's rated "nonadult". The second result is false, if media has no such
+// rating.
+func (self *Media) Adult() (adult, ok bool) {
+	for _, r := range self.allRatings() {
+		if !strings.EqualFold(r.Scheme, "urn:simple") {
+			continue
+		}
+
+		switch strings.ToLower(strings.TrimSpace(r.Value)) {
+		case "adult":
+			return true, true
+		case "nonadult":
+			return false, true
+		}
+	}
+	return false, false
+}
+
+func (self *Media) allRatings() []MediaRating {
+	ratings := slices.Clone(self.Ratings)
+	for c := range self.AllContents() {
+		ratings = append(ratings, c.Ratings...)
+	}
+	for _, g := range self.Groups {
+		ratings = append(ratings, g.Ratings...)
+	}
+	return ratings
+}
+
+// IsFree returns true if media has no prices, or all of them are zero and
+// don't have a paid type, like "rent" or "subscription". Media RSS defines
+// media without price type as free.
+func (self *Media) IsFree() bool {
+	isFree := func(prices []MediaPrice) bool {
+		return !slices.ContainsFunc(prices, func(p MediaPrice) bool {
+			return p.Price != 0 || p.Paid()
+		})
+	}
+
+	if !isFree(self.Prices) {
+		return false
+	}
+
+	for c := range self.AllContents() {
+		if !isFree(c.Prices) {
+			return false
+		}
+	}
+
+	for _, g := range self.Groups {
+		if !isFree(g.Prices) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContentForLang returns first media content with given language. It prefers
+// exact match, like "en-US", and falls back to primary language match, like
+// "en" for "en-US". It returns nil if nothing matches.
+func (self *Media) ContentForLang(lang string) *MediaContent {
+	if c := self.findContent(func(c *MediaContent) bool {
+		return strings.EqualFold(c.Lang, lang)
+	}); c != nil {
+		return c
+	}
+
+	primary, _, _ := strings.Cut(lang, "-")
+	return self.findContent(func(c *MediaContent) bool {
+		s, _, _ := strings.Cut(c.Lang, "-")
+		return strings.EqualFold(s, primary)
+	})
+}
+
+// ContentByExpression returns first media content with given expression, like
+// "full", "sample" or "nonstop". Content without expression is "full", as
+// defined by Media RSS spec. It returns nil if nothing matches.
+func (self *Media) ContentByExpression(expr string) *MediaContent {
+	return self.findContent(func(c *MediaContent) bool {
+		if c.Expression == "" {
+			return strings.EqualFold(expr, "full")
+		}
+		return strings.EqualFold(c.Expression, expr)
+	})
+}
+
+// DefaultContent returns media content with isDefault="true", which is the
+// default one of its group. It falls back to the first media content with URL.
+// It returns nil if media has no contents with URL.
+func (self *Media) DefaultContent() *MediaContent {
+	if c := self.findContent(func(c *MediaContent) bool {
+		return c.IsDefault && c.URL != ""
+	}); c != nil {
+		return c
+	}
+	return self.findContent(func(c *MediaContent) bool { return c.URL != "" })
+}
+
+func (self *Media) findContent(match func(c *MediaContent) bool,
+) *MediaContent {
+	for i := range self.Contents {
+		if c := &self.Contents[i]; match(c) {
+			return c
+		}
+	}
+
+	for i := range self.Groups {
+		g := &self.Groups[i]
+		for j := range g.Contents {
+			if c := &g.Contents[j]; match(c) {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
 func (self *Media) Description() string {
 	for _, d := range self.Descriptions {
 		if d.Type == "html" {
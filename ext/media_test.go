@@ -0,0 +1,172 @@
+package ext_test
+
+import (
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/options"
+	"github.com/dsh2dsh/gofeed/v2/rss"
+)
+
+func TestMedia_ContentSelectors(t *testing.T) {
+	f, err := os.Open("testdata/media/content_lang_expression.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	media := feed.Items[0].Media
+	require.NotNil(t, media)
+
+	langTests := []struct {
+		lang string
+		url  string
+	}{
+		{"en", "http://example.org/sample.mp3"},
+		{"en-US", "http://example.org/full-en.mp3"},
+		{"EN-us", "http://example.org/full-en.mp3"},
+		{"en-GB", "http://example.org/sample.mp3"},
+		{"de-AT", "http://example.org/full-de.mp3"},
+		{"fr", "http://example.org/stream.mp3"},
+		{"ja", ""},
+	}
+
+	for _, tt := range langTests {
+		t.Run("lang "+tt.lang, func(t *testing.T) {
+			c := media.ContentForLang(tt.lang)
+			if tt.url == "" {
+				assert.Nil(t, c)
+				return
+			}
+			require.NotNil(t, c)
+			assert.Equal(t, tt.url, c.URL)
+		})
+	}
+
+	exprTests := []struct {
+		expr string
+		url  string
+	}{
+		{"full", "http://example.org/full-en.mp3"},
+		{"sample", "http://example.org/sample.mp3"},
+		{"nonstop", "http://example.org/stream.mp3"},
+		{"unknown", ""},
+	}
+
+	for _, tt := range exprTests {
+		t.Run("expression "+tt.expr, func(t *testing.T) {
+			c := media.ContentByExpression(tt.expr)
+			if tt.url == "" {
+				assert.Nil(t, c)
+				return
+			}
+			require.NotNil(t, c)
+			assert.Equal(t, tt.url, c.URL)
+		})
+	}
+}
+
+func TestMedia_IsFree(t *testing.T) {
+	f, err := os.Open("testdata/media/price.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = rss.NewParser().Parse(f)
+	require.ErrorContains(t, err, `malformed price "cheap"`)
+
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	feed, err := rss.NewParser().Parse(f, options.WithLenient(true))
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 3)
+
+	paid := feed.Items[0].Media
+	require.NotNil(t, paid)
+	require.Len(t, paid.Contents, 1)
+	assert.Equal(t, []ext.MediaPrice{
+		{
+			Type:     "rent",
+			Price:    19.99,
+			Currency: "EUR",
+			Info:     "http://example.org/rent",
+		},
+	}, paid.Contents[0].Prices)
+	assert.False(t, paid.IsFree())
+
+	free := feed.Items[1].Media
+	require.NotNil(t, free)
+	assert.True(t, free.IsFree())
+	assert.True(t, (&ext.Media{}).IsFree())
+
+	subscription := feed.Items[2].Media
+	require.NotNil(t, subscription)
+	assert.False(t, subscription.IsFree())
+}
+
+func TestMedia_BestThumbnail(t *testing.T) {
+	f, err := os.Open("testdata/media/thumbnails.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	media := feed.Items[0].Media
+	require.NotNil(t, media)
+
+	tests := []struct {
+		width int
+		url   string
+	}{
+		{0, "http://example.org/small.jpg"},
+		{100, "http://example.org/small.jpg"},
+		{120, "http://example.org/small.jpg"},
+		{121, "http://example.org/medium.jpg"},
+		{320, "http://example.org/medium.jpg"},
+		{640, "http://example.org/large.jpg"},
+		{1920, "http://example.org/large.jpg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.width), func(t *testing.T) {
+			assert.Equal(t, tt.url, media.BestThumbnail(tt.width))
+		})
+	}
+
+	assert.Empty(t, (&ext.Media{}).BestThumbnail(100))
+}
+
+func TestMedia_AllTags(t *testing.T) {
+	f, err := os.Open("testdata/media/tags.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	media := feed.Items[0].Media
+	require.NotNil(t, media)
+
+	assert.Equal(t, []ext.MediaTag{
+		{Name: "news", Weight: 5},
+		{Name: "entertainment", Weight: 3},
+		{Name: "funny", Weight: 1},
+	}, media.Tags)
+
+	require.Len(t, media.Groups, 1)
+	assert.Equal(t, 20, media.Groups[0].Community.StarRating.Count)
+	assert.Equal(t, []ext.MediaTag{
+		{Name: "video", Weight: 10},
+		{Name: "music", Weight: 1},
+	}, media.Groups[0].Tags)
+
+	assert.Len(t, slices.Collect(media.AllTags()), 5)
+}
@@ -0,0 +1,8 @@
+package ext
+
+// PingbackExtension represents a feed extension for the pingback module
+// (http://madskills.com/public/xml/rss/module/pingback/).
+type PingbackExtension struct {
+	Server string `json:"server,omitempty"`
+	Target string `json:"target,omitempty"`
+}
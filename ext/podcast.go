@@ -0,0 +1,24 @@
+package ext
+
+// PodcastItemExtension represents item elements of the Podcasting 2.0 namespace
+// (https://podcastindex.org/namespace/1.0). Only elements, which the parser
+// knows, are here. Other elements of the namespace are parsed into
+// [Extensions].
+type PodcastItemExtension struct {
+	Season  *PodcastSeason  `json:"season,omitempty"`
+	Episode *PodcastEpisode `json:"episode,omitempty"`
+}
+
+// PodcastSeason is podcast:season of the item, with optional name of the
+// season, like "Race for the Whitehouse 2020".
+type PodcastSeason struct {
+	Number int    `json:"number"`
+	Name   string `json:"name,omitempty"`
+}
+
+// PodcastEpisode is podcast:episode of the item, with optional display name of
+// the episode, like "Ch.3". Number can be fractional, like 204.5.
+type PodcastEpisode struct {
+	Number  float64 `json:"number"`
+	Display string  `json:"display,omitempty"`
+}
@@ -0,0 +1,14 @@
+package ext
+
+// ReferenceExtension represents a feed extension for the reference module
+// (http://purl.org/rss/1.0/modules/reference/).
+type ReferenceExtension struct {
+	References []Reference `json:"references,omitempty"`
+}
+
+// Reference is a typed reference to another resource. Rel describes relation
+// of the resource to the item, like "related" or "via".
+type Reference struct {
+	Resource string `json:"resource,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
@@ -0,0 +1,27 @@
+package ext
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SearchExtension represents a feed extension for the search module
+// (http://purl.org/rss/1.0/modules/search/), which search-result feeds use to
+// rank items.
+type SearchExtension struct {
+	Score     string `json:"score,omitempty"`
+	Relevance string `json:"relevance,omitempty"`
+}
+
+// ScoreValue returns <search:score>, or <search:relevance> if the score is
+// missing, as a number. It returns false if both are missing or not a number.
+func (self *SearchExtension) ScoreValue() (float64, bool) {
+	for _, s := range [...]string{self.Score, self.Relevance} {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
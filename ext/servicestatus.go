@@ -0,0 +1,9 @@
+package ext
+
+// ServiceStatusExtension represents a feed extension for the servicestatus
+// module (http://purl.org/rss/1.0/modules/servicestatus/), which conveys
+// status of services in monitoring feeds.
+type ServiceStatusExtension struct {
+	Status string `json:"status,omitempty"`
+	Since  string `json:"since,omitempty"`
+}
@@ -0,0 +1,22 @@
+package ext
+
+import "strings"
+
+// StreamingExtension represents a feed extension for the streaming module
+// (http://hacks.benhammersley.com/rss/streaming/), which marks live or
+// streamed content.
+type StreamingExtension struct {
+	Live string `json:"live,omitempty"`
+	Type string `json:"type,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// IsLive returns true if <str:live> is a true value, like "true" or "yes", or
+// <str:type> is "live".
+func (self *StreamingExtension) IsLive() bool {
+	switch strings.ToLower(strings.TrimSpace(self.Live)) {
+	case "true", "yes", "1":
+		return true
+	}
+	return strings.EqualFold(strings.TrimSpace(self.Type), "live")
+}
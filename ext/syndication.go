@@ -0,0 +1,58 @@
+package ext
+
+import (
+	"strings"
+	"time"
+)
+
+// SyndicationExtension represents a feed extension for the syndication module
+// (http://purl.org/rss/1.0/modules/syndication/).
+type SyndicationExtension struct {
+	UpdatePeriod     string     `json:"updatePeriod,omitempty"`
+	UpdateFrequency  int        `json:"updateFrequency,omitempty"`
+	UpdateBase       string     `json:"updateBase,omitempty"`
+	UpdateBaseParsed *time.Time `json:"updateBaseParsed,omitempty"`
+}
+
+// Interval returns expected interval between updates of the feed, which is
+// UpdatePeriod divided by UpdateFrequency. Missing period is "daily" and
+// missing frequency is 1, as defined by the module. Month is 30 days and year
+// is 365 days long. It returns zero for unknown period.
+func (self *SyndicationExtension) Interval() time.Duration {
+	const day = 24 * time.Hour
+
+	var period time.Duration
+	switch strings.ToLower(strings.TrimSpace(self.UpdatePeriod)) {
+	case "hourly":
+		period = time.Hour
+	case "daily", "":
+		period = day
+	case "weekly":
+		period = 7 * day
+	case "monthly":
+		period = 30 * day
+	case "yearly":
+		period = 365 * day
+	default:
+		return 0
+	}
+	return period / time.Duration(max(self.UpdateFrequency, 1))
+}
+
+// NextUpdate returns the first expected update of the feed after given time,
+// counting intervals from UpdateBaseParsed. It returns false if UpdateBase is
+// missing or period is unknown.
+func (self *SyndicationExtension) NextUpdate(after time.Time,
+) (time.Time, bool) {
+	base := self.UpdateBaseParsed
+	interval := self.Interval()
+	if base == nil || interval <= 0 {
+		return time.Time{}, false
+	}
+
+	if after.Before(*base) {
+		return *base, true
+	}
+	n := after.Sub(*base)/interval + 1
+	return base.Add(n * interval), true
+}
@@ -0,0 +1,83 @@
+package ext_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+)
+
+func TestSyndicationExtension_NextUpdate(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	const week = 7 * 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		sy       ext.SyndicationExtension
+		after    time.Time
+		expected time.Time
+		ok       bool
+	}{
+		{
+			name:     "weekly before base",
+			sy:       ext.SyndicationExtension{UpdatePeriod: "weekly"},
+			after:    base.Add(-time.Hour),
+			expected: base,
+			ok:       true,
+		},
+		{
+			name:     "weekly",
+			sy:       ext.SyndicationExtension{UpdatePeriod: "weekly"},
+			after:    base.Add(10 * 24 * time.Hour),
+			expected: base.Add(2 * week),
+			ok:       true,
+		},
+		{
+			name:     "weekly at update",
+			sy:       ext.SyndicationExtension{UpdatePeriod: "weekly"},
+			after:    base.Add(week),
+			expected: base.Add(2 * week),
+			ok:       true,
+		},
+		{
+			name: "weekly twice",
+			sy: ext.SyndicationExtension{
+				UpdatePeriod:    "weekly",
+				UpdateFrequency: 2,
+			},
+			after:    base.Add(4 * 24 * time.Hour),
+			expected: base.Add(week),
+			ok:       true,
+		},
+		{
+			name:     "default daily",
+			after:    base.Add(36 * time.Hour),
+			expected: base.Add(48 * time.Hour),
+			ok:       true,
+		},
+		{
+			name:  "unknown period",
+			sy:    ext.SyndicationExtension{UpdatePeriod: "fortnightly"},
+			after: base,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.sy.UpdateBaseParsed = &base
+			next, ok := tt.sy.NextUpdate(tt.after)
+			require.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, next)
+		})
+	}
+}
+
+func TestSyndicationExtension_NextUpdate_noBase(t *testing.T) {
+	sy := ext.SyndicationExtension{UpdatePeriod: "weekly"}
+	next, ok := sy.NextUpdate(time.Now())
+	assert.False(t, ok)
+	assert.True(t, next.IsZero())
+}
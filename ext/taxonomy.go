@@ -0,0 +1,10 @@
+package ext
+
+// TaxonomyNamespace is the namespace of the taxonomy module.
+const TaxonomyNamespace = "http://purl.org/rss/1.0/modules/taxonomy/"
+
+// TaxonomyExtension represents a feed extension for the taxonomy module
+// (http://purl.org/rss/1.0/modules/taxonomy/).
+type TaxonomyExtension struct {
+	Topics []string `json:"topics,omitempty"`
+}
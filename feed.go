@@ -1,10 +1,21 @@
 package gofeed
 
 import (
+	"cmp"
+	"fmt"
+	"iter"
+	"mime"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/dsh2dsh/gofeed/v2/atom"
 	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/htmltext"
 	"github.com/dsh2dsh/gofeed/v2/internal/json"
 )
 
@@ -14,31 +25,48 @@ import (
 // Sorting with sort.Sort will order the Items by
 // oldest to newest publish time.
 type Feed struct {
-	Title           string                   `json:"title,omitempty"`
-	Description     string                   `json:"description,omitempty"`
-	Link            string                   `json:"link,omitempty"`
-	FeedLink        string                   `json:"feedLink,omitempty"`
-	Links           []string                 `json:"links,omitempty"`
-	Updated         string                   `json:"updated,omitempty"`
-	UpdatedParsed   *time.Time               `json:"updatedParsed,omitempty"`
-	Published       string                   `json:"published,omitempty"`
-	PublishedParsed *time.Time               `json:"publishedParsed,omitempty"`
-	Author          *Person                  `json:"author,omitempty"` // Deprecated: Use feed.Authors instead
-	Authors         []*Person                `json:"authors,omitempty"`
-	Language        string                   `json:"language,omitempty"`
-	Image           *Image                   `json:"image,omitempty"`
-	Copyright       string                   `json:"copyright,omitempty"`
-	Generator       string                   `json:"generator,omitempty"`
-	Categories      []string                 `json:"categories,omitempty"`
-	AtomExt         *atom.Feed               `json:"atomExt,omitempty"`
-	DublinCoreExt   *ext.DublinCoreExtension `json:"dcExt,omitempty"`
-	ITunesExt       *ext.ITunesFeedExtension `json:"itunesExt,omitempty"`
-	Extensions      ext.Extensions           `json:"extensions,omitempty"`
-	Items           []*Item                  `json:"items,omitempty"`
-	FeedType        string                   `json:"feedType,omitempty"`
-	FeedVersion     string                   `json:"feedVersion,omitempty"`
-
-	// Original format-specific feed data (only populated if KeepOriginalFeed is true)
+	Title            string                    `json:"title,omitempty"`
+	TitleType        string                    `json:"titleType,omitempty"` // "html" if Title contains HTML markup
+	Description      string                    `json:"description,omitempty"`
+	Link             string                    `json:"link,omitempty"`
+	FeedLink         string                    `json:"feedLink,omitempty"`
+	Links            []string                  `json:"links,omitempty"`
+	Updated          string                    `json:"updated,omitempty"`
+	UpdatedParsed    *time.Time                `json:"updatedParsed,omitempty"`
+	Published        string                    `json:"published,omitempty"`
+	PublishedParsed  *time.Time                `json:"publishedParsed,omitempty"`
+	BuildDate        string                    `json:"buildDate,omitempty"` // rss lastBuildDate
+	BuildDateParsed  *time.Time                `json:"buildDateParsed,omitempty"`
+	Author           *Person                   `json:"author,omitempty"` // Deprecated: Use feed.Authors instead
+	Authors          []*Person                 `json:"authors,omitempty"`
+	Contributors     []*Person                 `json:"contributors,omitempty"`
+	Language         string                    `json:"language,omitempty"`
+	Image            *Image                    `json:"image,omitempty"`
+	Favicon          string                    `json:"favicon,omitempty"`
+	Copyright        string                    `json:"copyright,omitempty"`
+	Docs             string                    `json:"docs,omitempty"`
+	Generator        string                    `json:"generator,omitempty"`
+	GeneratorName    string                    `json:"generatorName,omitempty"`
+	GeneratorVersion string                    `json:"generatorVersion,omitempty"`
+	Categories       []string                  `json:"categories,omitempty"`
+	Blocked          bool                      `json:"blocked,omitempty"`    // itunes:block
+	Complete         bool                      `json:"complete,omitempty"`   // itunes:complete
+	NewFeedURL       string                    `json:"newFeedUrl,omitempty"` // itunes:new-feed-url
+	AtomExt          *atom.Feed                `json:"atomExt,omitempty"`
+	DublinCoreExt    *ext.DublinCoreExtension  `json:"dcExt,omitempty"`
+	ITunesExt        *ext.ITunesFeedExtension  `json:"itunesExt,omitempty"`
+	SyndicationExt   *ext.SyndicationExtension `json:"syExt,omitempty"`
+	Extensions       ext.Extensions            `json:"extensions,omitempty"`
+	UnknownElements  []ext.Extension           `json:"unknownElements,omitempty"`
+	Items            []*Item                   `json:"items,omitempty"`
+	FeedType         string                    `json:"feedType,omitempty"`
+	FeedVersion      string                    `json:"feedVersion,omitempty"`
+	DetectedCharset  string                    `json:"detectedCharset,omitempty"`
+	Stylesheet       string                    `json:"stylesheet,omitempty"`
+	FetchedURL       string                    `json:"fetchedUrl,omitempty"`
+
+	// Original format-specific feed data (only populated if KeepOriginalFeed is
+	// true). See [Feed.RSSFeed], [Feed.AtomFeed] and [Feed.JSONFeed].
 	OriginalFeed any `json:"-"`
 }
 
@@ -48,6 +76,14 @@ func (f *Feed) String() string {
 	return s
 }
 
+// MarshalJSON returns JSON representation of the Feed. Fields are in the order
+// of declaration, time fields are in RFC3339 format and HTML characters aren't
+// escaped, unless the caller's encoder escapes them.
+func (f *Feed) MarshalJSON() ([]byte, error) {
+	type alias Feed
+	return json.Marshal((*alias)(f))
+}
+
 // GetExtension retrieves extension values by namespace and element name.
 // Returns a slice of Extension structs for the given namespace and element.
 // For non-namespaced RSS elements, use "rss" as the namespace.
@@ -80,29 +116,272 @@ func (f *Feed) GetCustomValue(element string) string {
 	return f.GetExtensionValue("_custom", element)
 }
 
+// PodcastType returns type of the podcast from itunes:type, like "episodic" or
+// "serial".
+func (f *Feed) PodcastType() string {
+	if f.ITunesExt == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(f.ITunesExt.Type))
+}
+
+// ImageURL returns URL of the best available image of the feed. It's URL of
+// Image, which is channel image, itunes:image or media image of RSS feeds, logo
+// or icon of Atom feeds and icon of JSON feeds. Otherwise it's itunes:image,
+// logo or icon of Atom extension and Favicon, in this order.
+func (f *Feed) ImageURL() string {
+	switch {
+	case f.Image != nil && f.Image.URL != "":
+		return f.Image.URL
+	case f.ITunesExt != nil && f.ITunesExt.Image != "":
+		return f.ITunesExt.Image
+	case f.AtomExt != nil && f.AtomExt.ImageURL() != "":
+		return f.AtomExt.ImageURL()
+	}
+	return f.Favicon
+}
+
+// HasMoved returns true if the podcast moved to NewFeedURL, which subscribers
+// must follow instead of the current feed URL.
+func (f *Feed) HasMoved() bool { return f.NewFeedURL != "" }
+
+// OrderItemsByEpisode sorts items by itunes:season and then by itunes:episode,
+// like podcast players do for serial podcasts. Items without episode number
+// are moved to the end, keeping their order.
+func (f *Feed) OrderItemsByEpisode() {
+	slices.SortStableFunc(f.Items, func(a, b *Item) int {
+		aSeason, aEpisode := a.itunesEpisode()
+		bSeason, bEpisode := b.itunesEpisode()
+		switch {
+		case aEpisode == 0 && bEpisode == 0:
+			return 0
+		case aEpisode == 0:
+			return 1
+		case bEpisode == 0:
+			return -1
+		}
+		return cmp.Or(cmp.Compare(aSeason, bSeason),
+			cmp.Compare(aEpisode, bEpisode))
+	})
+}
+
+// SortBy sorts Items in place by less, which reports whether a must sort before
+// b. The sort is stable, so equal items keep their original order. It mutates
+// Items of the feed.
+func (f *Feed) SortBy(less func(a, b *Item) bool) {
+	slices.SortStableFunc(f.Items, func(a, b *Item) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	})
+}
+
+// ItemsSeq returns an iterator over items of the feed.
+func (f *Feed) ItemsSeq() iter.Seq[*Item] {
+	return func(yield func(*Item) bool) {
+		for _, item := range f.Items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ItemsWithCategory returns an iterator over items of the feed, which have
+// given category. Categories are compared case-insensitively.
+func (f *Feed) ItemsWithCategory(category string) iter.Seq[*Item] {
+	return func(yield func(*Item) bool) {
+		for _, item := range f.Items {
+			hasCategory := slices.ContainsFunc(item.Categories,
+				func(s string) bool { return strings.EqualFold(s, category) })
+			if hasCategory && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// CategoryCounts returns number of items per category, like for a tag cloud.
+// Categories are compared case-insensitively and counted once per item. Keys
+// of returned map are categories as they first appeared in items.
+func (f *Feed) CategoryCounts() map[string]int {
+	counts := make(map[string]int)
+	for c := range f.itemCategories() {
+		counts[c]++
+	}
+	return counts
+}
+
+// AllItemCategories returns distinct categories of all items, in order of
+// their first appearance. Categories are compared case-insensitively.
+func (f *Feed) AllItemCategories() []string {
+	var categories []string
+	seen := make(map[string]struct{})
+	for c := range f.itemCategories() {
+		if _, ok := seen[c]; !ok {
+			seen[c] = struct{}{}
+			categories = append(categories, c)
+		}
+	}
+	return categories
+}
+
+// itemCategories iterates over trimmed categories of items, once per item,
+// using the first spelling of every category.
+func (f *Feed) itemCategories() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		names := make(map[string]string)
+		for _, item := range f.Items {
+			seen := make(map[string]struct{}, len(item.Categories))
+			for _, c := range item.Categories {
+				c = strings.TrimSpace(c)
+				key := strings.ToLower(c)
+				if _, ok := seen[key]; ok || c == "" {
+					continue
+				}
+				seen[key] = struct{}{}
+
+				if name, ok := names[key]; ok {
+					c = name
+				} else {
+					names[key] = c
+				}
+				if !yield(c) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// NextUpdate returns the next expected update of the feed, according to its
+// syndication module elements. It returns false if the feed doesn't define
+// <sy:updateBase>.
+func (f *Feed) NextUpdate() (time.Time, bool) {
+	if f.SyndicationExt == nil {
+		return time.Time{}, false
+	}
+	return f.SyndicationExt.NextUpdate(time.Now())
+}
+
+// Page returns up to limit items, starting from offset. Negative offset is
+// treated as zero. It returns an empty slice if offset is past the end of
+// items or limit isn't positive.
+func (f *Feed) Page(offset, limit int) []*Item {
+	offset = max(offset, 0)
+	if offset >= len(f.Items) || limit <= 0 {
+		return []*Item{}
+	}
+	limit = min(limit, len(f.Items)-offset)
+	return f.Items[offset : offset+limit]
+}
+
+// ITunesSummaryText returns <itunes:summary> of the feed as plain text, without
+// HTML tags. Raw summary is in ITunesExt.
+func (f *Feed) ITunesSummaryText() string {
+	if f.ITunesExt == nil {
+		return ""
+	}
+	return f.ITunesExt.SummaryText()
+}
+
+// EffectiveLanguage returns Language of the feed. If it's empty, it returns
+// the most common language of items, or dc:language of the feed, in that
+// order.
+func (f *Feed) EffectiveLanguage() string {
+	if f.Language != "" {
+		return f.Language
+	}
+
+	var lang string
+	counts := make(map[string]int)
+	for _, item := range f.Items {
+		if item.Language == "" {
+			continue
+		}
+		counts[item.Language]++
+		if counts[item.Language] > counts[lang] {
+			lang = item.Language
+		}
+	}
+
+	if lang == "" && f.DublinCoreExt != nil {
+		return f.DublinCoreExt.Language
+	}
+	return lang
+}
+
+// CategoriesString returns categories of the feed joined by sep. Empty and
+// duplicate categories are skipped.
+func (f *Feed) CategoriesString(sep string) string {
+	return joinCategories(f.Categories, sep)
+}
+
 // Item is the universal Item type that atom.Entry
 // and rss.Item gets translated to.  It represents
 // a single entry in a given feed.
 type Item struct {
-	Title           string                   `json:"title,omitempty"`
-	Description     string                   `json:"description,omitempty"`
-	Content         string                   `json:"content,omitempty"`
-	Link            string                   `json:"link,omitempty"`
-	Links           []string                 `json:"links,omitempty"`
-	Updated         string                   `json:"updated,omitempty"`
-	UpdatedParsed   *time.Time               `json:"updatedParsed,omitempty"`
-	Published       string                   `json:"published,omitempty"`
-	PublishedParsed *time.Time               `json:"publishedParsed,omitempty"`
-	Author          *Person                  `json:"author,omitempty"` // Deprecated: Use item.Authors instead
-	Authors         []*Person                `json:"authors,omitempty"`
-	GUID            string                   `json:"guid,omitempty"`
-	Image           *Image                   `json:"image,omitempty"`
-	Categories      []string                 `json:"categories,omitempty"`
-	Enclosures      []*Enclosure             `json:"enclosures,omitempty"`
-	AtomExt         *atom.Entry              `json:"atomExt,omitempty"`
-	DublinCoreExt   *ext.DublinCoreExtension `json:"dcExt,omitempty"`
-	ITunesExt       *ext.ITunesItemExtension `json:"itunesExt,omitempty"`
-	Extensions      ext.Extensions           `json:"extensions,omitempty"`
+	Title            string                      `json:"title,omitempty"`
+	TitleType        string                      `json:"titleType,omitempty"` // "html" if Title contains HTML markup
+	Description      string                      `json:"description,omitempty"`
+	Content          string                      `json:"content,omitempty"`
+	Link             string                      `json:"link,omitempty"`
+	Links            []string                    `json:"links,omitempty"`
+	RelatedLinks     []string                    `json:"relatedLinks,omitempty"`
+	LinkDetails      []Link                      `json:"linkDetails,omitempty"`
+	ExternalURL      string                      `json:"externalUrl,omitempty"`
+	EditURL          string                      `json:"editUrl,omitempty"`
+	CommentsFeedURL  string                      `json:"commentsFeedUrl,omitempty"`
+	CommentCount     *int                        `json:"commentCount,omitempty"`
+	Updated          string                      `json:"updated,omitempty"`
+	UpdatedParsed    *time.Time                  `json:"updatedParsed,omitempty"`
+	Published        string                      `json:"published,omitempty"`
+	PublishedParsed  *time.Time                  `json:"publishedParsed,omitempty"`
+	Author           *Person                     `json:"author,omitempty"` // Deprecated: Use item.Authors instead
+	Authors          []*Person                   `json:"authors,omitempty"`
+	Contributors     []*Person                   `json:"contributors,omitempty"`
+	GUID             string                      `json:"guid,omitempty"`
+	Language         string                      `json:"language,omitempty"`
+	Image            *Image                      `json:"image,omitempty"`
+	BannerImage      *Image                      `json:"bannerImage,omitempty"`
+	Categories       []string                    `json:"categories,omitempty"`
+	Keywords         []string                    `json:"keywords,omitempty"`
+	Adult            *bool                       `json:"adult,omitempty"` // media:rating or itunes:explicit
+	Duration         time.Duration               `json:"duration,omitempty"`
+	Season           *int                        `json:"season,omitempty"`      // itunes:season
+	Episode          *int                        `json:"episode,omitempty"`     // itunes:episode
+	SeasonName       string                      `json:"seasonName,omitempty"`  // podcast:season name
+	EpisodeName      string                      `json:"episodeName,omitempty"` // podcast:episode display
+	Enclosures       []*Enclosure                `json:"enclosures,omitempty"`
+	Source           *Source                     `json:"source,omitempty"`
+	AtomExt          *atom.Entry                 `json:"atomExt,omitempty"`
+	DublinCoreExt    *ext.DublinCoreExtension    `json:"dcExt,omitempty"`
+	ITunesExt        *ext.ITunesItemExtension    `json:"itunesExt,omitempty"`
+	EmailExt         *ext.EmailExtension         `json:"emailExt,omitempty"`
+	ServiceStatusExt *ext.ServiceStatusExtension `json:"serviceStatusExt,omitempty"`
+	GeoExt           *ext.GeoExtension           `json:"geoExt,omitempty"`
+	PodcastExt       *ext.PodcastItemExtension   `json:"podcastExt,omitempty"`
+	Extensions       ext.Extensions              `json:"extensions,omitempty"`
+	UnknownElements  []ext.Extension             `json:"unknownElements,omitempty"`
+
+	// Index is position of the item in document order, starting from 0. It
+	// allows to restore document order of items after sorting. Items skipped by
+	// [options.WithItemsSince] aren't counted, so Index is position of the item
+	// among parsed items, not among all items of the original feed.
+	Index int `json:"index,omitempty"`
+}
+
+func (i *Item) itunesEpisode() (season, episode int) {
+	if i.ITunesExt == nil {
+		return 0, 0
+	}
+	season, _ = i.ITunesExt.SeasonNumber()
+	episode, _ = i.ITunesExt.EpisodeNumber()
+	return season, episode
 }
 
 // GetExtension retrieves extension values by namespace and element name.
@@ -138,11 +417,143 @@ func (i *Item) GetCustomValue(element string) string {
 	return i.GetExtensionValue("_custom", element)
 }
 
+// Excerpt returns a plain text preview of the item, up to maxRunes runes long.
+// It strips HTML from Content, or Description if Content is empty, and
+// collapses whitespace. Longer text is truncated at a word boundary and ends
+// with an ellipsis.
+func (i *Item) Excerpt(maxRunes int) string {
+	s := i.Content
+	if s == "" {
+		s = i.Description
+	}
+
+	s = htmltext.Strip(s)
+	if maxRunes <= 0 || s == "" {
+		return ""
+	} else if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	runes := []rune(s)
+	cut := runes[:maxRunes]
+	if !unicode.IsSpace(runes[maxRunes]) {
+		// cut at the last word boundary, if there is any
+		for j := len(cut) - 1; j > 0; j-- {
+			if unicode.IsSpace(cut[j]) {
+				cut = cut[:j]
+				break
+			}
+		}
+	}
+	return strings.TrimRightFunc(string(cut), unicode.IsSpace) + "…"
+}
+
+// ContentWithBase returns Content, or Description if Content is empty, with
+// relative URLs of links, images and other media, like href of <a> or src of
+// <img>, resolved against Link of the item. It allows to render the content
+// outside of the item page. The content is returned as is, if Link isn't an
+// absolute URL.
+func (i *Item) ContentWithBase() string {
+	s := i.Content
+	if s == "" {
+		s = i.Description
+	}
+
+	base, err := url.Parse(i.Link)
+	if err != nil || !base.IsAbs() {
+		return s
+	}
+	return htmltext.ResolveURLs(s, base)
+}
+
+// CategoriesString returns categories of the item joined by sep. Empty and
+// duplicate categories are skipped.
+func (i *Item) CategoriesString(sep string) string {
+	return joinCategories(i.Categories, sep)
+}
+
+func joinCategories(categories []string, sep string) string {
+	if len(categories) == 0 {
+		return ""
+	}
+
+	unique := make([]string, 0, len(categories))
+	seen := make(map[string]struct{}, len(categories))
+	for _, c := range categories {
+		c = strings.TrimSpace(c)
+		if _, ok := seen[c]; ok || c == "" {
+			continue
+		}
+		seen[c] = struct{}{}
+		unique = append(unique, c)
+	}
+	return strings.Join(unique, sep)
+}
+
+// EmailFrom returns sender of the item from email:from of email-to-RSS
+// gateways, or nil if the item has no sender.
+func (i *Item) EmailFrom() *Person { return emailPerson(i.EmailExt) }
+
+func emailPerson(email *ext.EmailExtension) *Person {
+	if email == nil || email.From == (ext.EmailAddress{}) {
+		return nil
+	}
+	return &Person{Name: email.From.Name, Email: email.From.Address}
+}
+
+// ServiceStatus returns status of the service from ss:status of monitoring
+// feeds, or empty string if the item has no status.
+func (i *Item) ServiceStatus() string {
+	if i.ServiceStatusExt == nil {
+		return ""
+	}
+	return i.ServiceStatusExt.Status
+}
+
+// Elevation returns georss:elev of the item in meters and true, or false if the
+// item has no elevation.
+func (i *Item) Elevation() (float64, bool) {
+	return i.GeoExt.Elevation()
+}
+
+// AllLinks returns all links of the item with their metadata, like rel and
+// type, including links of any rel. If LinkDetails is empty, it returns Links
+// without metadata.
+func (i *Item) AllLinks() []Link {
+	if len(i.LinkDetails) != 0 || len(i.Links) == 0 {
+		return i.LinkDetails
+	}
+
+	links := make([]Link, len(i.Links))
+	for j, href := range i.Links {
+		links[j] = Link{Href: href}
+	}
+	return links
+}
+
+// Link is a link of the item with its metadata, like an Atom link.
+type Link struct {
+	Href     string `json:"href,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Hreflang string `json:"hreflang,omitempty"`
+	Title    string `json:"title,omitempty"`
+}
+
+// Source is the feed, which the item was republished from.
+type Source struct {
+	Title    string `json:"title,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Link     string `json:"link,omitempty"`
+	FeedLink string `json:"feedLink,omitempty"`
+}
+
 // Person is an individual specified in a feed
 // (e.g. an author)
 type Person struct {
 	Name  string `json:"name,omitempty"`
 	Email string `json:"email,omitempty"`
+	URL   string `json:"url,omitempty"`
 }
 
 // Image is an image that is the artwork for a given
@@ -150,6 +561,7 @@ type Person struct {
 type Image struct {
 	URL   string `json:"url,omitempty"`
 	Title string `json:"title,omitempty"`
+	Link  string `json:"link,omitempty"`
 }
 
 // Enclosure is a file associated with a given Item.
@@ -159,6 +571,63 @@ type Enclosure struct {
 	Type   string `json:"type,omitempty"`
 }
 
+// ParsedURL returns URL of the enclosure, parsed by [url.Parse].
+func (e *Enclosure) ParsedURL() (*url.URL, error) {
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return nil, fmt.Errorf("gofeed: parse enclosure url: %w", err)
+	}
+	return u, nil
+}
+
+// Filename returns the last segment of URL path of the enclosure, without
+// query and fragment. It returns empty string if URL can't be parsed or has no
+// path.
+func (e *Enclosure) Filename() string {
+	u, err := e.ParsedURL()
+	if err != nil {
+		return ""
+	}
+
+	p := strings.TrimRight(u.Path, "/")
+	if p == "" {
+		return ""
+	}
+	return path.Base(p)
+}
+
+// Extension returns file extension of the enclosure, like ".mp3", from its
+// URL path. It returns empty string if the file has no extension.
+func (e *Enclosure) Extension() string {
+	return path.Ext(e.Filename())
+}
+
+// MediaType returns media type of the enclosure without parameters, like
+// "audio/mpeg" for "audio/mpeg; charset=binary", and its parameters. It
+// returns Type as is and nil params, if Type is malformed.
+func (e *Enclosure) MediaType() (mediatype string, params map[string]string) {
+	mediatype, params, err := mime.ParseMediaType(e.Type)
+	if err != nil {
+		return e.Type, nil
+	}
+	return mediatype, params
+}
+
+// IsAudio returns true if media type of the enclosure is "audio/*".
+func (e *Enclosure) IsAudio() bool { return e.hasMainType("audio") }
+
+// IsVideo returns true if media type of the enclosure is "video/*".
+func (e *Enclosure) IsVideo() bool { return e.hasMainType("video") }
+
+// IsImage returns true if media type of the enclosure is "image/*".
+func (e *Enclosure) IsImage() bool { return e.hasMainType("image") }
+
+func (e *Enclosure) hasMainType(mainType string) bool {
+	mediatype, _ := e.MediaType()
+	s, _, _ := strings.Cut(mediatype, "/")
+	return strings.EqualFold(strings.TrimSpace(s), mainType)
+}
+
 // Len returns the length of Items.
 func (f Feed) Len() int {
 	return len(f.Items)
@@ -1,10 +1,18 @@
 package gofeed_test
 
 import (
+	"encoding/json"
+	"iter"
+	"math"
+	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/dsh2dsh/gofeed/v2"
 	"github.com/dsh2dsh/gofeed/v2/ext"
 )
@@ -248,3 +256,853 @@ func TestMultipleExtensionsWithSameName(t *testing.T) {
 		t.Errorf("Expected 'First' (first value), got '%s'", v)
 	}
 }
+
+func TestFeed_MarshalJSON(t *testing.T) {
+	const feedData = `<rss version="2.0"><channel>
+<title>Feed &lt;Title&gt;</title>
+<lastBuildDate>Mon, 21 Apr 2025 06:00:00 GMT</lastBuildDate>
+<item>
+  <title>Item</title>
+  <description><![CDATA[<p>a & b</p>]]></description>
+  <pubDate>Mon, 21 Apr 2025 06:00:00 EDT</pubDate>
+  <custom id="1">value</custom>
+</item>
+</channel></rss>`
+
+	feed, err := gofeed.NewParser().Parse(strings.NewReader(feedData))
+	require.NoError(t, err)
+
+	b, err := feed.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"title":"Feed <Title>"`)
+	assert.Contains(t, string(b), `"publishedParsed":"2025-04-21T10:00:00Z"`)
+
+	var got gofeed.Feed
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, feed, &got)
+
+	assert.True(t, json.Valid([]byte(feed.String())))
+}
+
+func TestItem_Excerpt(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     gofeed.Item
+		maxRunes int
+		expected string
+	}{
+		{
+			name: "html content",
+			item: gofeed.Item{
+				Content: `<p>Hello <b>brave</b>&nbsp;new</p><p>world &amp; more</p>`,
+			},
+			maxRunes: 100,
+			expected: "Hello brave new world & more",
+		},
+		{
+			name: "truncated at word boundary",
+			item: gofeed.Item{
+				Content: "<div>The quick brown fox jumps over the lazy dog</div>",
+			},
+			maxRunes: 18,
+			expected: "The quick brown…",
+		},
+		{
+			name: "truncated at space",
+			item: gofeed.Item{
+				Content: "The quick brown fox",
+			},
+			maxRunes: 15,
+			expected: "The quick brown…",
+		},
+		{
+			name: "single long word",
+			item: gofeed.Item{
+				Content: "Supercalifragilistic",
+			},
+			maxRunes: 5,
+			expected: "Super…",
+		},
+		{
+			name: "multibyte",
+			item: gofeed.Item{
+				Content: "<p>Привет, мир! Съешь же ещё этих мягких булок</p>",
+			},
+			maxRunes: 15,
+			expected: "Привет, мир!…",
+		},
+		{
+			name: "description fallback",
+			item: gofeed.Item{
+				Description: "<script>alert(1)</script>Short <i>text</i>",
+			},
+			maxRunes: 10,
+			expected: "Short text",
+		},
+		{
+			name:     "empty",
+			item:     gofeed.Item{},
+			maxRunes: 10,
+		},
+		{
+			name:     "zero maxRunes",
+			item:     gofeed.Item{Content: "text"},
+			maxRunes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.item.Excerpt(tt.maxRunes))
+		})
+	}
+}
+
+func TestItem_ContentWithBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     gofeed.Item
+		expected string
+	}{
+		{
+			name: "relative urls",
+			item: gofeed.Item{
+				Link: "https://example.org/blog/post.html",
+				Content: `<p>See <a href="other.html">other</a> and ` +
+					`<a href="/about">about</a>.</p>` +
+					`<img src="images/pic.png" alt="Pic"/>` +
+					`<video poster="poster.jpg"><source src="//cdn.example.org/v.mp4"></video>`,
+			},
+			expected: `<p>See <a href="https://example.org/blog/other.html">other</a> and ` +
+				`<a href="https://example.org/about">about</a>.</p>` +
+				`<img src="https://example.org/blog/images/pic.png" alt="Pic"/>` +
+				`<video poster="https://example.org/blog/poster.jpg">` +
+				`<source src="https://cdn.example.org/v.mp4"></video>`,
+		},
+		{
+			name: "absolute urls kept",
+			item: gofeed.Item{
+				Link: "https://example.org/blog/post.html",
+				Content: `<a href="https://example.com/x">x</a> ` +
+					`<a href="mailto:me@example.org">me</a> <img src="data:image/png;base64,AA==">`,
+			},
+			expected: `<a href="https://example.com/x">x</a> ` +
+				`<a href="mailto:me@example.org">me</a> <img src="data:image/png;base64,AA==">`,
+		},
+		{
+			name: "srcset",
+			item: gofeed.Item{
+				Link:    "https://example.org/blog/",
+				Content: `<img srcset="small.jpg 1x, large.jpg 2x">`,
+			},
+			expected: `<img srcset="https://example.org/blog/small.jpg 1x, ` +
+				`https://example.org/blog/large.jpg 2x">`,
+		},
+		{
+			name: "description",
+			item: gofeed.Item{
+				Link:        "https://example.org/blog/post.html",
+				Description: `<img src="pic.png">`,
+			},
+			expected: `<img src="https://example.org/blog/pic.png">`,
+		},
+		{
+			name: "relative link",
+			item: gofeed.Item{
+				Link:    "/blog/post.html",
+				Content: `<img src="pic.png">`,
+			},
+			expected: `<img src="pic.png">`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.item.ContentWithBase())
+		})
+	}
+}
+
+func TestFeed_Page(t *testing.T) {
+	feed := gofeed.Feed{
+		Items: []*gofeed.Item{{Title: "0"}, {Title: "1"}, {Title: "2"}},
+	}
+
+	titles := func(items []*gofeed.Item) []string {
+		s := make([]string, len(items))
+		for i, item := range items {
+			s[i] = item.Title
+		}
+		return s
+	}
+
+	tests := []struct {
+		name     string
+		offset   int
+		limit    int
+		expected []string
+	}{
+		{"first page", 0, 2, []string{"0", "1"}},
+		{"last page", 2, 2, []string{"2"}},
+		{"limit larger than remaining", 1, 10, []string{"1", "2"}},
+		{"huge limit", 1, math.MaxInt, []string{"1", "2"}},
+		{"negative offset", -5, 2, []string{"0", "1"}},
+		{"offset at length", 3, 2, []string{}},
+		{"offset beyond length", 10, 2, []string{}},
+		{"zero limit", 0, 0, []string{}},
+		{"negative limit", 0, -1, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := feed.Page(tt.offset, tt.limit)
+			require.NotNil(t, page)
+			assert.Equal(t, tt.expected, titles(page))
+		})
+	}
+
+	assert.Empty(t, (&gofeed.Feed{}).Page(0, 10))
+}
+
+func TestEnclosure_URL(t *testing.T) {
+	tests := []struct {
+		url       string
+		filename  string
+		extension string
+		wantErr   bool
+	}{
+		{
+			url:       "https://cdn.example.org/podcast/episode-1.mp3?token=abc&x=1",
+			filename:  "episode-1.mp3",
+			extension: ".mp3",
+		},
+		{
+			url:       "https://cdn.example.org/media/video.v2.mp4#t=10",
+			filename:  "video.v2.mp4",
+			extension: ".mp4",
+		},
+		{
+			url:      "https://cdn.example.org/files/download/",
+			filename: "download",
+		},
+		{
+			url: "https://cdn.example.org",
+		},
+		{
+			url: "https://cdn.example.org/?file=episode.mp3",
+		},
+		{
+			url:     "http://[::1]:namedport/file.mp3",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			e := gofeed.Enclosure{URL: tt.url}
+			u, err := e.ParsedURL()
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, u)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.url, u.String())
+			}
+			assert.Equal(t, tt.filename, e.Filename())
+			assert.Equal(t, tt.extension, e.Extension())
+		})
+	}
+}
+
+func TestEnclosure_MediaType(t *testing.T) {
+	tests := []struct {
+		typ       string
+		mediatype string
+		params    map[string]string
+		audio     bool
+		video     bool
+		image     bool
+	}{
+		{
+			typ:       "audio/mpeg",
+			mediatype: "audio/mpeg",
+			params:    map[string]string{},
+			audio:     true,
+		},
+		{
+			typ:       "audio/mpeg; charset=binary",
+			mediatype: "audio/mpeg",
+			params:    map[string]string{"charset": "binary"},
+			audio:     true,
+		},
+		{
+			typ:       `Video/MP4; codecs="avc1.42E01E, mp4a.40.2"`,
+			mediatype: "video/mp4",
+			params:    map[string]string{"codecs": "avc1.42E01E, mp4a.40.2"},
+			video:     true,
+		},
+		{
+			typ:       "image/*",
+			mediatype: "image/*",
+			params:    map[string]string{},
+			image:     true,
+		},
+		{
+			typ:       "audio/mpeg;;",
+			mediatype: "audio/mpeg;;",
+			audio:     true,
+		},
+		{
+			typ:       "",
+			mediatype: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			e := gofeed.Enclosure{Type: tt.typ}
+			mediatype, params := e.MediaType()
+			assert.Equal(t, tt.mediatype, mediatype)
+			assert.Equal(t, tt.params, params)
+			assert.Equal(t, tt.audio, e.IsAudio())
+			assert.Equal(t, tt.video, e.IsVideo())
+			assert.Equal(t, tt.image, e.IsImage())
+		})
+	}
+}
+
+func TestFeed_itunesBlock(t *testing.T) {
+	f, err := os.Open("testdata/parser/rss_itunes_block.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	assert.True(t, feed.Blocked)
+	assert.True(t, feed.Complete)
+
+	require.Len(t, feed.Items, 2)
+	assert.True(t, feed.Items[0].ITunesExt.IsBlocked())
+	assert.False(t, feed.Items[1].ITunesExt.IsBlocked())
+
+	feed, err = gofeed.NewParser().Parse(strings.NewReader(
+		`<rss version="2.0"><channel><title>Podcast</title></channel></rss>`))
+	require.NoError(t, err)
+	assert.False(t, feed.Blocked)
+	assert.False(t, feed.Complete)
+}
+
+func TestFeed_ImageURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		feed     string
+		expected string
+	}{
+		{
+			name: "rss image",
+			feed: `<rss version="2.0"><channel>
+<image><url>http://example.org/image.png</url></image>
+</channel></rss>`,
+			expected: "http://example.org/image.png",
+		},
+		{
+			name: "itunes image",
+			feed: `<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"><channel>
+<itunes:image href="http://example.org/itunes.png" />
+</channel></rss>`,
+			expected: "http://example.org/itunes.png",
+		},
+		{
+			name: "media thumbnail",
+			feed: `<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/"><channel>
+<media:thumbnail url="http://example.org/small.png" width="64" />
+<media:thumbnail url="http://example.org/large.png" width="512" />
+</channel></rss>`,
+			expected: "http://example.org/large.png",
+		},
+		{
+			name: "rss atom logo",
+			feed: `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel>
+<atom:logo>http://example.org/logo.png</atom:logo>
+</channel></rss>`,
+			expected: "http://example.org/logo.png",
+		},
+		{
+			name: "atom logo",
+			feed: `<feed xmlns="http://www.w3.org/2005/Atom">
+<logo>http://example.org/logo.png</logo>
+</feed>`,
+			expected: "http://example.org/logo.png",
+		},
+		{
+			name: "atom icon",
+			feed: `<feed xmlns="http://www.w3.org/2005/Atom">
+<icon>http://example.org/icon.png</icon>
+</feed>`,
+			expected: "http://example.org/icon.png",
+		},
+		{
+			name: "json favicon",
+			feed: `{"version": "https://jsonfeed.org/version/1.1",
+"favicon": "http://example.org/favicon.ico"}`,
+			expected: "http://example.org/favicon.ico",
+		},
+		{
+			name: "without image",
+			feed: `<rss version="2.0"><channel><title>t</title></channel></rss>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser().Parse(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, feed.ImageURL())
+		})
+	}
+}
+
+func TestFeed_HasMoved(t *testing.T) {
+	f, err := os.Open("testdata/parser/rss_itunes_new_feed_url.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	assert.Equal(t, "https://new.example.com/feed.xml", feed.NewFeedURL)
+	assert.True(t, feed.HasMoved())
+
+	feed, err = gofeed.NewParser().Parse(strings.NewReader(
+		`<rss version="2.0"><channel><title>Podcast</title></channel></rss>`))
+	require.NoError(t, err)
+	assert.Empty(t, feed.NewFeedURL)
+	assert.False(t, feed.HasMoved())
+}
+
+func TestFeed_BuildDate(t *testing.T) {
+	f, err := os.Open("testdata/translator/rss/" +
+		"feed_build_date_-_rss_channel_pubDate_lastBuildDate.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	assert.Equal(t, "Mon, 02 Sep 2002 09:00:00 GMT", feed.Published)
+	assert.Equal(t, "Sat, 07 Sep 2002 00:00:01 GMT", feed.BuildDate)
+	require.NotNil(t, feed.PublishedParsed)
+	require.NotNil(t, feed.BuildDateParsed)
+	assert.True(t, feed.BuildDateParsed.After(*feed.PublishedParsed))
+
+	feed, err = gofeed.NewParser().Parse(strings.NewReader(`<rss version="2.0"
+xmlns:dc="http://purl.org/dc/elements/1.1/"><channel>
+<dc:date>2002-09-07T00:00:01Z</dc:date>
+</channel></rss>`))
+	require.NoError(t, err)
+	assert.Equal(t, "2002-09-07T00:00:01Z", feed.Updated)
+	assert.Empty(t, feed.BuildDate)
+	assert.Nil(t, feed.BuildDateParsed)
+}
+
+func TestItem_ServiceStatus(t *testing.T) {
+	f, err := os.Open(
+		"testdata/translator/rss/feed_item_service_status_-_rss_channel_item_ss.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "degraded", feed.Items[0].ServiceStatus())
+	assert.Empty(t, (&gofeed.Item{}).ServiceStatus())
+}
+
+func TestItem_Elevation(t *testing.T) {
+	f, err := os.Open(
+		"testdata/translator/rss/feed_item_geo_-_rss_channel_item_georss.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+	require.NotNil(t, item.GeoExt)
+	assert.Equal(t, "Mount Washington", item.GeoExt.FeatureName)
+
+	elev, ok := item.Elevation()
+	assert.True(t, ok)
+	assert.InDelta(t, 1917.5, elev, 0)
+
+	_, ok = (&gofeed.Item{}).Elevation()
+	assert.False(t, ok)
+}
+
+func TestItem_License(t *testing.T) {
+	tests := []struct {
+		name        string
+		license     string
+		attribution bool
+		commercial  bool
+	}{
+		{
+			name:        "by-nc-sa",
+			license:     "http://creativecommons.org/licenses/by-nc-sa/2.0/",
+			attribution: true,
+		},
+		{
+			name:        "by",
+			license:     "https://creativecommons.org/licenses/by/4.0/",
+			attribution: true,
+			commercial:  true,
+		},
+		{
+			name:        "by-sa",
+			license:     "https://www.creativecommons.org/licenses/by-sa/4.0/deed.en",
+			attribution: true,
+			commercial:  true,
+		},
+		{
+			name:       "cc0",
+			license:    "https://creativecommons.org/publicdomain/zero/1.0/",
+			commercial: true,
+		},
+		{
+			name:    "unknown",
+			license: "https://example.org/license",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser().Parse(strings.NewReader(`<rss version="2.0"
+xmlns:creativeCommons="http://backend.userland.com/creativeCommonsRssModule">
+<channel><item>
+<creativeCommons:license>` + tt.license + `</creativeCommons:license>
+</item></channel></rss>`))
+			require.NoError(t, err)
+			require.Len(t, feed.Items, 1)
+
+			license, attribution, commercial := feed.Items[0].License()
+			assert.Equal(t, tt.license, license)
+			assert.Equal(t, tt.attribution, attribution, "requiresAttribution")
+			assert.Equal(t, tt.commercial, commercial, "allowsCommercial")
+		})
+	}
+
+	feed, err := gofeed.NewParser().Parse(strings.NewReader(`<rss version="2.0"
+xmlns:cc="http://web.resource.org/cc/"
+xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"><channel><item>
+<cc:license rdf:resource="http://creativecommons.org/licenses/by-nc/4.0/"/>
+</item></channel></rss>`))
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	license, attribution, commercial := feed.Items[0].License()
+	assert.Equal(t, "http://creativecommons.org/licenses/by-nc/4.0/", license)
+	assert.True(t, attribution)
+	assert.False(t, commercial)
+
+	license, attribution, commercial = (&gofeed.Item{}).License()
+	assert.Empty(t, license)
+	assert.False(t, attribution)
+	assert.False(t, commercial)
+}
+
+func TestItem_podcastSeasonEpisode(t *testing.T) {
+	f, err := os.Open("testdata/translator/rss/" +
+		"feed_item_episode_-_rss_channel_item_podcast_season_episode.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 2)
+
+	item := feed.Items[0]
+	require.NotNil(t, item.Season)
+	assert.Equal(t, 3, *item.Season)
+	assert.Equal(t, "Race for the Whitehouse 2020", item.SeasonName)
+	require.NotNil(t, item.Episode)
+	assert.Equal(t, 42, *item.Episode)
+	assert.Equal(t, "Ch.3", item.EpisodeName)
+
+	item = feed.Items[1]
+	require.NotNil(t, item.PodcastExt)
+	require.NotNil(t, item.PodcastExt.Episode)
+	assert.InDelta(t, 42.5, item.PodcastExt.Episode.Number, 0)
+	assert.Nil(t, item.Episode)
+	assert.Empty(t, item.SeasonName)
+}
+
+func TestFeed_OrderItemsByEpisode(t *testing.T) {
+	f, err := os.Open("testdata/parser/rss_itunes_serial.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	assert.Equal(t, "serial", feed.PodcastType())
+
+	feed.OrderItemsByEpisode()
+	titles := make([]string, len(feed.Items))
+	for i, item := range feed.Items {
+		titles[i] = item.Title
+	}
+	assert.Equal(t,
+		[]string{"S1E1", "S1E2", "S1E10", "S2E1", "Trailer"}, titles)
+
+	assert.Empty(t, (&gofeed.Feed{}).PodcastType())
+}
+
+func TestFeed_SortBy(t *testing.T) {
+	feed := &gofeed.Feed{Items: []*gofeed.Item{
+		{Title: "Charlie", GUID: "1"},
+		{Title: "alpha", GUID: "2"},
+		{Title: "Bravo", GUID: "3"},
+		{Title: "Alpha", GUID: "4"},
+	}}
+
+	feed.SortBy(func(a, b *gofeed.Item) bool {
+		return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+	})
+
+	guids := make([]string, len(feed.Items))
+	for i, item := range feed.Items {
+		guids[i] = item.GUID
+	}
+	assert.Equal(t, []string{"2", "4", "3", "1"}, guids)
+}
+
+func TestFeed_NextUpdate(t *testing.T) {
+	f, err := os.Open("testdata/parser/rss_syndication.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.NotNil(t, feed.SyndicationExt)
+	assert.Equal(t, "weekly", feed.SyndicationExt.UpdatePeriod)
+	assert.Equal(t, 1, feed.SyndicationExt.UpdateFrequency)
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NotNil(t, feed.SyndicationExt.UpdateBaseParsed)
+	assert.Equal(t, base, *feed.SyndicationExt.UpdateBaseParsed)
+
+	next, ok := feed.NextUpdate()
+	require.True(t, ok)
+	assert.True(t, next.After(time.Now()))
+	assert.Zero(t, next.Sub(base)%(7*24*time.Hour))
+
+	_, ok = (&gofeed.Feed{}).NextUpdate()
+	assert.False(t, ok)
+}
+
+func TestItem_AllLinks(t *testing.T) {
+	tests := []struct {
+		name     string
+		feed     string
+		expected []gofeed.Link
+	}{
+		{
+			name: "atom entry",
+			feed: `<feed xmlns="http://www.w3.org/2005/Atom"><entry>
+<link href="http://example.org/entry" />
+<link rel="alternate" type="text/html" hreflang="fr" title="French"
+  href="http://example.org/fr/entry" />
+<link rel="related" href="http://example.org/related" />
+<link rel="enclosure" type="audio/mpeg" href="http://example.org/a.mp3" />
+</entry></feed>`,
+			expected: []gofeed.Link{
+				{Href: "http://example.org/entry", Rel: "alternate"},
+				{
+					Href:     "http://example.org/fr/entry",
+					Rel:      "alternate",
+					Type:     "text/html",
+					Hreflang: "fr",
+					Title:    "French",
+				},
+				{Href: "http://example.org/related", Rel: "related"},
+				{
+					Href: "http://example.org/a.mp3",
+					Rel:  "enclosure",
+					Type: "audio/mpeg",
+				},
+			},
+		},
+		{
+			name: "rss item with atom link",
+			feed: `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+<channel><item>
+<link>http://example.org/item</link>
+<atom:link rel="replies" type="application/atom+xml"
+  href="http://example.org/item/comments" />
+</item></channel></rss>`,
+			expected: []gofeed.Link{
+				{Href: "http://example.org/item", Rel: "alternate"},
+				{
+					Href: "http://example.org/item/comments",
+					Rel:  "replies",
+					Type: "application/atom+xml",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser().Parse(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, tt.expected, feed.Items[0].AllLinks())
+		})
+	}
+
+	item := gofeed.Item{Links: []string{"http://example.org/"}}
+	assert.Equal(t, []gofeed.Link{{Href: "http://example.org/"}},
+		item.AllLinks())
+	assert.Empty(t, (&gofeed.Item{}).AllLinks())
+}
+
+func TestItem_CategoriesString(t *testing.T) {
+	tests := []struct {
+		name       string
+		categories []string
+		sep        string
+		expected   string
+	}{
+		{"several", []string{"Go", "RSS", "Atom"}, ", ", "Go, RSS, Atom"},
+		{"custom separator", []string{"Go", "RSS"}, "|", "Go|RSS"},
+		{"duplicates", []string{"Go", " Go ", "", "RSS", "Go"}, ";", "Go;RSS"},
+		{"empty", nil, ",", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := gofeed.Item{Categories: tt.categories}
+			assert.Equal(t, tt.expected, item.CategoriesString(tt.sep))
+
+			feed := gofeed.Feed{Categories: tt.categories}
+			assert.Equal(t, tt.expected, feed.CategoriesString(tt.sep))
+		})
+	}
+}
+
+func TestFeed_EffectiveLanguage(t *testing.T) {
+	const feedData = `<rss version="2.0"><channel>
+<item><title>Eins</title><dc:language xmlns:dc="http://purl.org/dc/elements/1.1/">de</dc:language></item>
+<item><title>Zwei</title><dc:language xmlns:dc="http://purl.org/dc/elements/1.1/">de</dc:language></item>
+</channel></rss>`
+
+	feed, err := gofeed.NewParser().Parse(strings.NewReader(feedData))
+	require.NoError(t, err)
+	assert.Empty(t, feed.Language)
+	assert.Equal(t, "de", feed.EffectiveLanguage())
+
+	tests := []struct {
+		name     string
+		feed     gofeed.Feed
+		expected string
+	}{
+		{
+			name: "feed language",
+			feed: gofeed.Feed{
+				Language: "en",
+				Items:    []*gofeed.Item{{Language: "de"}},
+			},
+			expected: "en",
+		},
+		{
+			name: "most common item language",
+			feed: gofeed.Feed{
+				Items: []*gofeed.Item{
+					{Language: "fr"}, {Language: "de"}, {}, {Language: "de"},
+				},
+			},
+			expected: "de",
+		},
+		{
+			name: "dublin core",
+			feed: gofeed.Feed{
+				DublinCoreExt: &ext.DublinCoreExtension{Language: "ru"},
+				Items:         []*gofeed.Item{{}},
+			},
+			expected: "ru",
+		},
+		{name: "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.feed.EffectiveLanguage())
+		})
+	}
+}
+
+func TestFeed_ITunesSummaryText(t *testing.T) {
+	f, err := os.Open("testdata/parser/rss_itunes_summary_html.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := gofeed.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.NotNil(t, feed.ITunesExt)
+	assert.Equal(t, `<p>Weekly talks about <b>Go</b> &amp; feeds.</p>
+<p>Hosted by <a href="http://example.org/">us</a>.</p>`, feed.ITunesExt.Summary)
+	assert.Equal(t, "Weekly talks about Go & feeds. Hosted by us.",
+		feed.ITunesSummaryText())
+
+	assert.Empty(t, (&gofeed.Feed{}).ITunesSummaryText())
+}
+
+func TestFeed_ItemsSeq(t *testing.T) {
+	feed := gofeed.Feed{
+		Items: []*gofeed.Item{
+			{Title: "1", Categories: []string{"Go"}},
+			{Title: "2", Categories: []string{"Rust"}},
+			{Title: "3", Categories: []string{"rust", "go"}},
+			{Title: "4"},
+			{Title: "5", Categories: []string{"GO"}},
+		},
+	}
+
+	titles := func(seq iter.Seq[*gofeed.Item], limit int) []string {
+		var titles []string
+		for item := range seq {
+			titles = append(titles, item.Title)
+			if len(titles) == limit {
+				break
+			}
+		}
+		return titles
+	}
+
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"},
+		titles(feed.ItemsSeq(), 0))
+	assert.Equal(t, []string{"1", "2"}, titles(feed.ItemsSeq(), 2))
+
+	assert.Equal(t, []string{"1", "3", "5"},
+		titles(feed.ItemsWithCategory("go"), 0))
+	assert.Equal(t, []string{"2"}, titles(feed.ItemsWithCategory("Rust"), 1))
+	assert.Empty(t, titles(feed.ItemsWithCategory("Zig"), 0))
+	assert.Empty(t, titles((&gofeed.Feed{}).ItemsSeq(), 0))
+}
+
+func TestFeed_CategoryCounts(t *testing.T) {
+	feed := gofeed.Feed{
+		Items: []*gofeed.Item{
+			{Categories: []string{"Go", "Feeds"}},
+			{Categories: []string{"go", " Rust ", "GO"}},
+			{Categories: []string{"rust", "", "feeds"}},
+			{},
+			{Categories: []string{"Zig"}},
+		},
+	}
+
+	assert.Equal(t, map[string]int{"Go": 2, "Feeds": 2, "Rust": 2, "Zig": 1},
+		feed.CategoryCounts())
+	assert.Equal(t, []string{"Go", "Feeds", "Rust", "Zig"},
+		feed.AllItemCategories())
+
+	empty := gofeed.Feed{}
+	assert.Empty(t, empty.CategoryCounts())
+	assert.Nil(t, empty.AllItemCategories())
+}
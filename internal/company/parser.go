@@ -0,0 +1,83 @@
+package company
+
+import (
+	"fmt"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the company module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "name", "ticker", "symbol", "exchange", "market":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p  *xml.Parser
+	co *ext.CompanyExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, co *ext.CompanyExtension,
+) (*ext.CompanyExtension, error) {
+	if co == nil {
+		co = &ext.CompanyExtension{}
+	}
+
+	self := parser{p: p, co: co}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.CompanyExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/company: unexpected state at the end: %w", err)
+	}
+	return self.co, nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "name":
+		self.co.Names = appendText(self.co.Names, self.p.Text())
+	case "ticker", "symbol":
+		self.co.Tickers = appendText(self.co.Tickers, self.p.Text())
+	case "exchange", "market":
+		self.co.Exchanges = appendText(self.co.Exchanges, self.p.Text())
+	default:
+		self.p.Skip(name)
+	}
+}
+
+func appendText(values []string, s string) []string {
+	if s == "" {
+		return values
+	}
+	return append(values, s)
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/company: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
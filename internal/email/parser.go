@@ -0,0 +1,87 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/shared"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the email module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "from", "to", "replyto":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p     *xml.Parser
+	email *ext.EmailExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, email *ext.EmailExtension,
+) (*ext.EmailExtension, error) {
+	if email == nil {
+		email = &ext.EmailExtension{}
+	}
+
+	self := parser{p: p, email: email}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.EmailExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/email: unexpected state at the end: %w", err)
+	}
+	return self.email, nil
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/email: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "from":
+		self.email.From = self.address()
+	case "to":
+		if addr := self.address(); addr != (ext.EmailAddress{}) {
+			self.email.To = append(self.email.To, addr)
+		}
+	case "replyto":
+		self.email.ReplyTo = self.address()
+	default:
+		self.p.Skip(name)
+	}
+}
+
+// address parses
+//
+//	<email:from>John Doe &lt;john@example.org&gt;</email:from>
+func (self *parser) address() ext.EmailAddress {
+	name, address := shared.ParseNameAddress(strings.TrimSpace(self.p.Text()))
+	return ext.EmailAddress{Name: name, Address: address}
+}
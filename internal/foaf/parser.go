@@ -0,0 +1,166 @@
+package foaf
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsPersonElement returns true if lowercased name is <foaf:Person>, or a
+// property, like <foaf:maker>, which wraps it. Parse knows only such elements.
+func IsPersonElement(name string) bool {
+	switch name {
+	case "person", "maker":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p    *xml.Parser
+	foaf *ext.FOAFExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, foaf *ext.FOAFExtension,
+) (*ext.FOAFExtension, error) {
+	if foaf == nil {
+		foaf = &ext.FOAFExtension{}
+	}
+
+	self := parser{p: p, foaf: foaf}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.FOAFExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/foaf: unexpected state at the end: %w", err)
+	}
+	return self.foaf, nil
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/foaf: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "person":
+		self.foaf.Persons = self.appendPerson(name, self.foaf.Persons)
+	case "maker":
+		// Property, which wraps <foaf:Person>.
+		self.foaf.Persons = self.appendWrapped(name, self.foaf.Persons)
+	default:
+		self.p.Skip(name)
+	}
+}
+
+// appendWrapped parses
+//
+//	<foaf:maker>
+//	  <foaf:Person>...</foaf:Person>
+//	</foaf:maker>
+func (self *parser) appendWrapped(name string, persons []ext.FOAFPerson,
+) []ext.FOAFPerson {
+	children := self.makeChildrenSeq(name)
+	if children == nil {
+		return persons
+	}
+
+	for name := range children {
+		if name == "person" {
+			persons = self.appendPerson(name, persons)
+		} else {
+			self.p.Skip(name)
+		}
+	}
+	return persons
+}
+
+// appendPerson parses
+//
+//	<foaf:Person>
+//	  <foaf:name>John Doe</foaf:name>
+//	  <foaf:mbox rdf:resource="mailto:john@example.org" />
+//	  <foaf:homepage rdf:resource="http://example.org/" />
+//	</foaf:Person>
+func (self *parser) appendPerson(name string, persons []ext.FOAFPerson,
+) []ext.FOAFPerson {
+	children := self.makeChildrenSeq(name)
+	if children == nil {
+		return persons
+	}
+
+	var person ext.FOAFPerson
+	for name := range children {
+		switch name {
+		case "name":
+			person.Name = self.p.Text()
+		case "mbox":
+			person.Mbox = self.resource(name)
+		case "homepage":
+			person.Homepage = self.resource(name)
+		default:
+			self.p.Skip(name)
+		}
+	}
+
+	if self.err != nil || person == (ext.FOAFPerson{}) {
+		return persons
+	}
+	return append(persons, person)
+}
+
+func (self *parser) resource(name string) string {
+	s, err := self.p.Resource(name)
+	if err != nil {
+		self.err = err
+	}
+	return strings.TrimSpace(s)
+}
+
+func (self *parser) makeChildrenSeq(name string) iter.Seq[string] {
+	children, err := self.p.MakeChildrenSeq(name)
+	if err != nil {
+		self.err = err
+		return nil
+	}
+
+	return func(yield func(string) bool) {
+		for name := range children {
+			if err := self.Err(); err != nil {
+				self.err = err
+				return
+			}
+
+			if !yield(name) {
+				break
+			}
+		}
+
+		if err := self.Err(); err != nil {
+			self.err = err
+			return
+		}
+	}
+}
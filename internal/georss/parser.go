@@ -0,0 +1,93 @@
+package georss
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of GeoRSS, which
+// Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "point", "box", "featuretypetag", "featurename", "relationshiptag",
+		"elev", "floor", "radius":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p   *xml.Parser
+	geo *ext.GeoExtension
+}
+
+func Parse(p *xml.Parser, geo *ext.GeoExtension) (*ext.GeoExtension, error) {
+	if geo == nil {
+		geo = &ext.GeoExtension{}
+	}
+
+	self := parser{p: p, geo: geo}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.GeoExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/georss: unexpected state at the end: %w", err)
+	}
+	return self.geo, nil
+}
+
+func (self *parser) Err() error {
+	if err := self.p.Err(); err != nil {
+		return fmt.Errorf("gofeed/georss: xml parser errored: %w", err)
+	}
+	return nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "point":
+		self.geo.Point = self.text()
+	case "box":
+		self.geo.Box = self.text()
+	case "featuretypetag":
+		self.geo.FeatureTypeTag = self.text()
+	case "featurename":
+		self.geo.FeatureName = self.text()
+	case "relationshiptag":
+		self.geo.RelationshipTag = self.text()
+	case "elev":
+		self.geo.Elev = self.float()
+	case "floor":
+		self.geo.Floor = self.text()
+	case "radius":
+		self.geo.Radius = self.float()
+	default:
+		self.p.Skip(name)
+	}
+}
+
+func (self *parser) text() string { return strings.TrimSpace(self.p.Text()) }
+
+// float returns text of current element as float64, or nil if it can't be
+// parsed.
+func (self *parser) float() *float64 {
+	f, err := strconv.ParseFloat(self.text(), 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
@@ -0,0 +1,106 @@
+package htmltext
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ResolveURLs returns HTML fragment s with relative URLs in link and media
+// attributes, like href of <a> or src of <img>, resolved against base. Other
+// parts of s are kept as is.
+func ResolveURLs(s string, base *url.URL) string {
+	if s == "" || base == nil {
+		return s
+	}
+
+	var sb strings.Builder
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return sb.String()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if tok, ok := resolveToken(z.Token(), base); ok {
+				sb.WriteString(tok.String())
+				continue
+			}
+		}
+		sb.Write(z.Raw())
+	}
+}
+
+// resolveToken resolves URL attributes of tok and returns true if any of them
+// changed.
+func resolveToken(tok html.Token, base *url.URL) (html.Token, bool) {
+	var changed bool
+	for i := range tok.Attr {
+		attr := &tok.Attr[i]
+		if attr.Namespace != "" {
+			continue
+		}
+
+		var v string
+		switch {
+		case urlAttr(tok.DataAtom, attr.Key):
+			v = resolveURL(base, attr.Val)
+		case attr.Key == "srcset" &&
+			(tok.DataAtom == atom.Img || tok.DataAtom == atom.Source):
+			v = resolveSrcset(base, attr.Val)
+		default:
+			continue
+		}
+
+		if v != attr.Val {
+			attr.Val = v
+			changed = true
+		}
+	}
+	return tok, changed
+}
+
+func urlAttr(a atom.Atom, key string) bool {
+	switch key {
+	case "href":
+		return a == atom.A || a == atom.Area
+	case "src":
+		switch a {
+		case atom.Img, atom.Source, atom.Video, atom.Audio, atom.Track,
+			atom.Iframe, atom.Embed:
+			return true
+		}
+	case "poster":
+		return a == atom.Video
+	}
+	return false
+}
+
+func resolveURL(base *url.URL, s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.IsAbs() {
+		return s
+	}
+	return base.ResolveReference(u).String()
+}
+
+// resolveSrcset resolves URLs of image candidates in srcset attribute, like
+// "small.jpg 1x, large.jpg 2x".
+func resolveSrcset(base *url.URL, s string) string {
+	candidates := strings.Split(s, ",")
+	for i, c := range candidates {
+		fields := strings.Fields(c)
+		if len(fields) == 0 {
+			continue
+		}
+		fields[0] = resolveURL(base, fields[0])
+		candidates[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(candidates, ", ")
+}
@@ -84,12 +84,23 @@ func (self *itemParser) Err() error {
 	return nil
 }
 
-func (self *itemParser) image(name string) (href string) {
-	err := self.p.WithSkip(name, func() error {
-		href = self.p.Attribute("href")
-		return nil
-	})
-	if err != nil {
+// image returns href attribute of itunes:image, or its text, if the attribute
+// is missing, like in some malformed feeds. Child elements, like <url>, are
+// skipped, keeping their text.
+func (self *itemParser) image(name string) string {
+	if err := self.p.Expect(xpp.StartTag, name); err != nil {
+		self.err = err
+		return ""
+	}
+
+	href := self.p.Attribute("href")
+	if s := self.p.DeepText(); self.p.Err() != nil {
+		return ""
+	} else if href == "" {
+		href = s
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
 		self.err = err
 		return ""
 	}
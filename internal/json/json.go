@@ -1,6 +1,7 @@
 package json
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -17,3 +18,15 @@ func MarshalString(v any) (string, error) {
 	}
 	return b.String(), nil
 }
+
+// Marshal returns compact JSON encoding of v without HTML escaping.
+func Marshal(v any) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("gofeed/internal/json: marshal: %w", err)
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte{'\n'}), nil
+}
@@ -73,6 +73,14 @@ func (self *parser) body(name string) {
 		m.Descriptions = self.appendDescription(name, m.Descriptions)
 	case "peerlink":
 		m.PeerLinks = self.appendPeerLink(name, m.PeerLinks)
+	case "price":
+		m.Prices = self.appendPrice(name, m.Prices)
+	case "rating":
+		m.Ratings = self.appendRating(name, m.Ratings)
+	case "tags":
+		m.Tags = self.appendTags(name, m.Tags)
+	case "community":
+		_, m.Tags = self.community(name, m.Tags)
 	default:
 		self.p.Skip(name)
 	}
@@ -114,6 +122,14 @@ func (self *parser) appendContent(name string, contents []ext.MediaContent,
 			c.FileSize = value
 		case "medium":
 			c.Medium = value
+		case "lang":
+			c.Lang = value
+		case "expression":
+			c.Expression = value
+		case "duration":
+			c.Duration = value
+		case "isdefault":
+			c.IsDefault = strings.EqualFold(value, "true")
 		case "height":
 			err = parseIntTo(name, value, &c.Height)
 		case "width":
@@ -140,6 +156,10 @@ func (self *parser) appendContent(name string, contents []ext.MediaContent,
 			c.Descriptions = self.appendDescription(name, c.Descriptions)
 		case "peerlink":
 			c.PeerLinks = self.appendPeerLink(name, c.PeerLinks)
+		case "price":
+			c.Prices = self.appendPrice(name, c.Prices)
+		case "rating":
+			c.Ratings = self.appendRating(name, c.Ratings)
 		default:
 			self.p.Skip(name)
 		}
@@ -258,6 +278,80 @@ func (self *parser) appendPeerLink(name string, links []ext.MediaPeerLink,
 	return append(links, link)
 }
 
+// appendPrice parses media:price element. In lenient mode it skips the element
+// with malformed price, instead of stop parsing with an error.
+func (self *parser) appendPrice(name string, prices []ext.MediaPrice,
+) []ext.MediaPrice {
+	var price ext.MediaPrice
+	var malformed bool
+	err := self.p.WithSkip(name, func() error {
+		for name, value := range self.p.AttributeSeq() {
+			switch name {
+			case "type":
+				price.Type = value
+			case "price":
+				if value == "" {
+					continue
+				}
+				v, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					if !self.p.Lenient() {
+						return fmt.Errorf("gofeed/media: malformed price %q: %w",
+							value, err)
+					}
+					malformed = true
+					continue
+				}
+				price.Price = v
+			case "currency":
+				price.Currency = value
+			case "info":
+				price.Info = value
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		self.err = err
+		return prices
+	}
+
+	if malformed {
+		return prices
+	}
+	return append(prices, price)
+}
+
+// appendRating parses
+//
+//	<media:rating scheme="urn:simple">adult</media:rating>
+//
+// Default scheme is "urn:simple".
+func (self *parser) appendRating(name string, ratings []ext.MediaRating,
+) []ext.MediaRating {
+	rating := ext.MediaRating{Scheme: "urn:simple"}
+	err := self.p.WithText(name,
+		func() error {
+			if s := strings.TrimSpace(self.p.Attribute("scheme")); s != "" {
+				rating.Scheme = s
+			}
+			return nil
+		},
+		func(s string) error {
+			rating.Value = s
+			return nil
+		})
+	if err != nil {
+		self.err = err
+		return ratings
+	}
+
+	if rating.Value == "" {
+		return ratings
+	}
+	return append(ratings, rating)
+}
+
 func (self *parser) appendGroup(name string, groups []ext.MediaGroup,
 ) []ext.MediaGroup {
 	children := self.makeChildrenSeq(name)
@@ -283,8 +377,14 @@ func (self *parser) appendGroup(name string, groups []ext.MediaGroup,
 			g.Descriptions = self.appendDescription(name, g.Descriptions)
 		case "peerlink":
 			g.PeerLinks = self.appendPeerLink(name, g.PeerLinks)
+		case "price":
+			g.Prices = self.appendPrice(name, g.Prices)
+		case "rating":
+			g.Ratings = self.appendRating(name, g.Ratings)
+		case "tags":
+			g.Tags = self.appendTags(name, g.Tags)
 		case "community":
-			g.Community = self.community(name)
+			g.Community, g.Tags = self.community(name, g.Tags)
 		default:
 			self.p.Skip(name)
 		}
@@ -296,10 +396,11 @@ func (self *parser) appendGroup(name string, groups []ext.MediaGroup,
 	return append(groups, g)
 }
 
-func (self *parser) community(name string) (community ext.MediaCommunity) {
+func (self *parser) community(name string, tags []ext.MediaTag,
+) (community ext.MediaCommunity, _ []ext.MediaTag) {
 	children := self.makeChildrenSeq(name)
 	if children == nil {
-		return community
+		return community, tags
 	}
 
 	for name := range children {
@@ -308,11 +409,40 @@ func (self *parser) community(name string) (community ext.MediaCommunity) {
 			community.StarRating = self.starRating(name)
 		case "statistics":
 			community.Statistics = self.statistics(name)
+		case "tags":
+			tags = self.appendTags(name, tags)
 		default:
 			self.p.Skip(name)
 		}
 	}
-	return community
+	return community, tags
+}
+
+// appendTags parses comma-separated tags with optional weight, like
+// "news: 5, entertainment: 3, funny". Default weight is 1.
+func (self *parser) appendTags(name string, tags []ext.MediaTag,
+) []ext.MediaTag {
+	err := self.p.WithText(name, nil, func(s string) error {
+		for s := range strings.SplitSeq(s, ",") {
+			tagName, weight, found := strings.Cut(s, ":")
+			tag := ext.MediaTag{Name: strings.TrimSpace(tagName), Weight: 1}
+			if tag.Name == "" {
+				continue
+			}
+
+			if found {
+				if n, err := strconv.Atoi(strings.TrimSpace(weight)); err == nil {
+					tag.Weight = n
+				}
+			}
+			tags = append(tags, tag)
+		}
+		return nil
+	})
+	if err != nil {
+		self.err = err
+	}
+	return tags
 }
 
 func (self *parser) starRating(name string) (rating ext.MediaStarRating) {
@@ -0,0 +1,82 @@
+package pingback
+
+import (
+	"fmt"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the pingback module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "server", "target":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p        *xml.Parser
+	pingback *ext.PingbackExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, pingback *ext.PingbackExtension,
+) (*ext.PingbackExtension, error) {
+	if pingback == nil {
+		pingback = &ext.PingbackExtension{}
+	}
+
+	self := parser{p: p, pingback: pingback}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.PingbackExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/pingback: unexpected state at the end: %w", err)
+	}
+	return self.pingback, nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "server":
+		self.pingback.Server = self.resource(name)
+	case "target":
+		self.pingback.Target = self.resource(name)
+	default:
+		self.p.Skip(name)
+	}
+}
+
+func (self *parser) resource(name string) string {
+	s, err := self.p.Resource(name)
+	if err != nil {
+		self.err = err
+	}
+	return s
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/pingback: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
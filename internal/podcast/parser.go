@@ -0,0 +1,122 @@
+package podcast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an item element of the
+// Podcasting 2.0 namespace, which ParseItem knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "season", "episode":
+		return true
+	}
+	return false
+}
+
+type itemParser struct {
+	p       *xml.Parser
+	podcast *ext.PodcastItemExtension
+	err     error
+}
+
+func ParseItem(p *xml.Parser, podcast *ext.PodcastItemExtension,
+) (*ext.PodcastItemExtension, error) {
+	if podcast == nil {
+		podcast = &ext.PodcastItemExtension{}
+	}
+
+	self := itemParser{p: p, podcast: podcast}
+	return self.Parse()
+}
+
+func (self *itemParser) Parse() (*ext.PodcastItemExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/podcast: unexpected state at the end: %w", err)
+	}
+	return self.podcast, nil
+}
+
+func (self *itemParser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/podcast: xml parser errored: %w", self.p.Err())
+	}
+	return nil
+}
+
+func (self *itemParser) body(name string) {
+	switch name {
+	case "season":
+		if season := self.season(name); season != nil {
+			self.podcast.Season = season
+		}
+	case "episode":
+		if episode := self.episode(name); episode != nil {
+			self.podcast.Episode = episode
+		}
+	default:
+		self.p.Skip(name)
+	}
+}
+
+// season returns podcast:season with its name, or nil if its number is
+// malformed.
+func (self *itemParser) season(name string) (season *ext.PodcastSeason) {
+	var seasonName string
+	err := self.p.WithText(name,
+		func() error {
+			seasonName = self.p.Attribute("name")
+			return nil
+		},
+		func(s string) error {
+			if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+				season = &ext.PodcastSeason{Number: n, Name: seasonName}
+			}
+			return nil
+		})
+	if err != nil {
+		self.err = fmt.Errorf("gofeed/podcast: parse season: %w", err)
+		return nil
+	}
+	return season
+}
+
+// episode returns podcast:episode with its display name, or nil if its number
+// is malformed.
+func (self *itemParser) episode(name string) (episode *ext.PodcastEpisode) {
+	var display string
+	err := self.p.WithText(name,
+		func() error {
+			display = self.p.Attribute("display")
+			return nil
+		},
+		func(s string) error {
+			n, err := strconv.ParseFloat(s, 64)
+			if err == nil && n >= 0 {
+				episode = &ext.PodcastEpisode{Number: n, Display: display}
+			}
+			return nil
+		})
+	if err != nil {
+		self.err = fmt.Errorf("gofeed/podcast: parse episode: %w", err)
+		return nil
+	}
+	return episode
+}
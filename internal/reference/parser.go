@@ -0,0 +1,173 @@
+package reference
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the reference module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "reference", "references":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p   *xml.Parser
+	ref *ext.ReferenceExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, ref *ext.ReferenceExtension,
+) (*ext.ReferenceExtension, error) {
+	if ref == nil {
+		ref = &ext.ReferenceExtension{}
+	}
+
+	self := parser{p: p, ref: ref}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.ReferenceExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/reference: unexpected state at the end: %w", err)
+	}
+	return self.ref, nil
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/reference: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "reference":
+		self.ref.References = self.appendReference(name, "",
+			self.ref.References)
+	case "references":
+		self.ref.References = self.appendReferences(name, self.ref.References)
+	default:
+		self.p.Skip(name)
+	}
+}
+
+// appendReference parses
+//
+//	<ref:reference rdf:resource="http://example.org/" ref:rel="related" />
+func (self *parser) appendReference(name, rel string, refs []ext.Reference,
+) []ext.Reference {
+	if s := self.p.Attribute("rel"); s != "" {
+		rel = s
+	}
+
+	resource, err := self.p.Resource(name)
+	if err != nil {
+		self.err = err
+		return refs
+	}
+
+	if resource == "" {
+		return refs
+	}
+	return append(refs, ext.Reference{
+		Resource: resource,
+		Rel:      strings.TrimSpace(rel),
+	})
+}
+
+// appendReferences parses
+//
+//	<ref:references ref:rel="related">
+//	  <rdf:Bag>
+//	    <rdf:li rdf:resource="http://example.org/" />
+//	  </rdf:Bag>
+//	</ref:references>
+func (self *parser) appendReferences(name string, refs []ext.Reference,
+) []ext.Reference {
+	rel := self.p.Attribute("rel")
+	children := self.makeChildrenSeq(name)
+	if children == nil {
+		return refs
+	}
+
+	for name := range children {
+		switch name {
+		case "bag", "seq", "alt":
+			refs = self.appendItems(name, rel, refs)
+		default:
+			self.p.Skip(name)
+		}
+	}
+	return refs
+}
+
+func (self *parser) appendItems(name, rel string, refs []ext.Reference,
+) []ext.Reference {
+	children := self.makeChildrenSeq(name)
+	if children == nil {
+		return refs
+	}
+
+	for name := range children {
+		if name != "li" {
+			self.p.Skip(name)
+			continue
+		}
+
+		refs = self.appendReference(name, rel, refs)
+		if self.err != nil {
+			return refs
+		}
+	}
+	return refs
+}
+
+func (self *parser) makeChildrenSeq(name string) iter.Seq[string] {
+	children, err := self.p.MakeChildrenSeq(name)
+	if err != nil {
+		self.err = err
+		return nil
+	}
+
+	return func(yield func(string) bool) {
+		for name := range children {
+			if err := self.Err(); err != nil {
+				self.err = err
+				return
+			}
+
+			if !yield(name) {
+				break
+			}
+		}
+
+		if err := self.Err(); err != nil {
+			self.err = err
+			return
+		}
+	}
+}
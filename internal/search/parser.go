@@ -0,0 +1,74 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the search module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "score", "relevance":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p      *xml.Parser
+	search *ext.SearchExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, search *ext.SearchExtension,
+) (*ext.SearchExtension, error) {
+	if search == nil {
+		search = &ext.SearchExtension{}
+	}
+
+	self := parser{p: p, search: search}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.SearchExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/search: unexpected state at the end: %w", err)
+	}
+	return self.search, nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "score":
+		self.search.Score = self.p.Text()
+	case "relevance":
+		self.search.Relevance = self.p.Text()
+	default:
+		self.p.Skip(name)
+	}
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/search: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
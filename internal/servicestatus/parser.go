@@ -0,0 +1,68 @@
+package servicestatus
+
+import (
+	"fmt"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the service status module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "status", "since":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p  *xml.Parser
+	ss *ext.ServiceStatusExtension
+}
+
+func Parse(p *xml.Parser, ss *ext.ServiceStatusExtension,
+) (*ext.ServiceStatusExtension, error) {
+	if ss == nil {
+		ss = &ext.ServiceStatusExtension{}
+	}
+
+	self := parser{p: p, ss: ss}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.ServiceStatusExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/servicestatus: unexpected state at the end: %w", err)
+	}
+	return self.ss, nil
+}
+
+func (self *parser) Err() error {
+	if err := self.p.Err(); err != nil {
+		return fmt.Errorf("gofeed/servicestatus: xml parser errored: %w", err)
+	}
+	return nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "status":
+		self.ss.Status = strings.TrimSpace(self.p.Text())
+	case "since":
+		self.ss.Since = strings.TrimSpace(self.p.Text())
+	default:
+		self.p.Skip(name)
+	}
+}
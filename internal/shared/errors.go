@@ -0,0 +1,30 @@
+package shared
+
+import "errors"
+
+var (
+	ErrInvalidXML              = errors.New("invalid xml")
+	ErrInvalidJSON             = errors.New("invalid json")
+	ErrUnexpectedEndOfDocument = errors.New("unexpected end of document")
+	ErrNoRootElement           = errors.New("no root element")
+)
+
+// WithCategory returns an error with the same message as err, which also
+// matches categories by [errors.Is].
+func WithCategory(err error, categories ...error) error {
+	if err == nil || len(categories) == 0 {
+		return err
+	}
+	return &categorizedError{err: err, categories: categories}
+}
+
+type categorizedError struct {
+	err        error
+	categories []error
+}
+
+func (self *categorizedError) Error() string { return self.err.Error() }
+
+func (self *categorizedError) Unwrap() []error {
+	return append([]error{self.err}, self.categories...)
+}
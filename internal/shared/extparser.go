@@ -16,10 +16,9 @@ var (
 
 // ParseExtension parses the current element of the
 // XMLPullParser as an extension element and updates
-// the extension map
-func ParseExtension(fe ext.Extensions, p *xpp.XMLPullParser) (ext.Extensions, error) {
-	prefix := PrefixForNamespace(p.Space, p)
-
+// the extension map under given namespace prefix.
+func ParseExtension(fe ext.Extensions, p *xpp.XMLPullParser, prefix string,
+) (ext.Extensions, error) {
 	result, err := parseExtensionElement(p)
 	if err != nil {
 		return nil, err
@@ -144,6 +143,7 @@ var canonicalNamespaces = map[string]string{
 	"http://cyber.law.harvard.edu/rss/creativeCommonsRssModule.html": "creativeCommons",
 	"http://backend.userland.com/creativeCommonsRssModule":           "creativeCommons",
 	"http://purl.org/rss/1.0/modules/company":                        "co",
+	"http://purl.org/rss/1.0/modules/company/":                       "co",
 	"http://purl.org/rss/1.0/modules/content/":                       "content",
 	"http://my.theinfo.org/changed/1.0/rss/":                         "cp",
 	"http://purl.org/dc/elements/1.1/":                               "dc",
@@ -164,6 +164,7 @@ var canonicalNamespaces = map[string]string{
 	"http://search.yahoo.com/mrss":                                   "media",
 	"http://search.yahoo.com/mrss/":                                  "media",
 	"http://madskills.com/public/xml/rss/module/pingback/":           "pingback",
+	"https://podcastindex.org/namespace/1.0":                         "podcast",
 	"http://prismstandard.org/namespaces/1.2/basic/":                 "prism",
 	"http://www.w3.org/1999/02/22-rdf-syntax-ns#":                    "rdf",
 	"http://www.w3.org/2000/01/rdf-schema#":                          "rdfs",
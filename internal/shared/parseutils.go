@@ -7,12 +7,14 @@ import (
 var (
 	emailNameRgx = regexp.MustCompile(`^([^@]+@[^\s]+)\s+\(([^@]+)\)$`)
 	nameEmailRgx = regexp.MustCompile(`^([^@]+)\s+\(([^@]+@[^)]+)\)$`)
+	nameAngleRgx = regexp.MustCompile(`^([^<>]*?)\s*<([^@<>]+@[^<>]+)>$`)
 	nameOnlyRgx  = regexp.MustCompile(`^([^@()]+)$`)
 	emailOnlyRgx = regexp.MustCompile(`^([^@()]+@[^@()]+)$`)
 )
 
 // ParseNameAddress parses name/email strings commonly found in RSS feeds of the
-// format "Example Name (example@site.com)" and other variations of this format.
+// format "Example Name (example@site.com)" and other variations of this format,
+// like "Example Name <example@site.com>" of email headers.
 func ParseNameAddress(s string) (name, address string) {
 	if s == "" {
 		return "", ""
@@ -26,6 +28,10 @@ func ParseNameAddress(s string) (name, address string) {
 		return m[1], m[2]
 	}
 
+	if m := nameAngleRgx.FindStringSubmatch(s); m != nil {
+		return m[1], m[2]
+	}
+
 	if m := nameOnlyRgx.FindStringSubmatch(s); m != nil {
 		return m[1], ""
 	}
@@ -0,0 +1,84 @@
+package streaming
+
+import (
+	"fmt"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the streaming module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "live", "type", "url":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p   *xml.Parser
+	str *ext.StreamingExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, str *ext.StreamingExtension,
+) (*ext.StreamingExtension, error) {
+	if str == nil {
+		str = &ext.StreamingExtension{}
+	}
+
+	self := parser{p: p, str: str}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.StreamingExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/streaming: unexpected state at the end: %w", err)
+	}
+	return self.str, nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "live":
+		self.str.Live = self.p.Text()
+	case "type":
+		self.str.Type = self.p.Text()
+	case "url":
+		self.str.URL = self.resource(name)
+	default:
+		self.p.Skip(name)
+	}
+}
+
+func (self *parser) resource(name string) string {
+	s, err := self.p.Resource(name)
+	if err != nil {
+		self.err = err
+	}
+	return s
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/streaming: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package taxonomy
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+)
+
+// IsItemElement returns true if lowercased name is an element of the taxonomy module,
+// which Parse knows.
+func IsItemElement(name string) bool {
+	switch name {
+	case "topics":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	p    *xml.Parser
+	taxo *ext.TaxonomyExtension
+
+	err error
+}
+
+func Parse(p *xml.Parser, taxo *ext.TaxonomyExtension,
+) (*ext.TaxonomyExtension, error) {
+	if taxo == nil {
+		taxo = &ext.TaxonomyExtension{}
+	}
+
+	self := parser{p: p, taxo: taxo}
+	return self.Parse()
+}
+
+func (self *parser) Parse() (*ext.TaxonomyExtension, error) {
+	name := strings.ToLower(self.p.Name)
+	self.body(name)
+	if err := self.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := self.p.Expect(xpp.EndTag, name); err != nil {
+		return nil, fmt.Errorf(
+			"gofeed/taxonomy: unexpected state at the end: %w", err)
+	}
+	return self.taxo, nil
+}
+
+func (self *parser) Err() error {
+	switch {
+	case self.err != nil:
+		return self.err
+	case self.p.Err() != nil:
+		return fmt.Errorf("gofeed/taxonomy: xml parser errored: %w",
+			self.p.Err())
+	}
+	return nil
+}
+
+func (self *parser) body(name string) {
+	switch name {
+	case "topics":
+		self.taxo.Topics = self.appendTopics(name, self.taxo.Topics)
+	default:
+		self.p.Skip(name)
+	}
+}
+
+// appendTopics parses
+//
+//	<taxo:topics>
+//	  <rdf:Bag>
+//	    <rdf:li resource="http://example.org/topic" />
+//	  </rdf:Bag>
+//	</taxo:topics>
+func (self *parser) appendTopics(name string, topics []string) []string {
+	children := self.makeChildrenSeq(name)
+	if children == nil {
+		return topics
+	}
+
+	for name := range children {
+		switch name {
+		case "bag", "seq", "alt":
+			topics = self.appendItems(name, topics)
+		default:
+			self.p.Skip(name)
+		}
+	}
+	return topics
+}
+
+func (self *parser) appendItems(name string, topics []string) []string {
+	children := self.makeChildrenSeq(name)
+	if children == nil {
+		return topics
+	}
+
+	for name := range children {
+		if name != "li" {
+			self.p.Skip(name)
+			continue
+		}
+
+		resource, err := self.p.Resource(name)
+		if err != nil {
+			self.err = err
+			return topics
+		}
+
+		if resource != "" {
+			topics = append(topics, resource)
+		}
+	}
+	return topics
+}
+
+func (self *parser) makeChildrenSeq(name string) iter.Seq[string] {
+	children, err := self.p.MakeChildrenSeq(name)
+	if err != nil {
+		self.err = err
+		return nil
+	}
+
+	return func(yield func(string) bool) {
+		for name := range children {
+			if err := self.Err(); err != nil {
+				self.err = err
+				return
+			}
+
+			if !yield(name) {
+				break
+			}
+		}
+
+		if err := self.Err(); err != nil {
+			self.err = err
+			return
+		}
+	}
+}
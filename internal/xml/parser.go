@@ -1,6 +1,7 @@
 package xml
 
 import (
+	stdxml "encoding/xml"
 	"errors"
 	"fmt"
 	"io"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	xpp "github.com/dsh2dsh/goxpp/v2"
+	"golang.org/x/net/html/charset"
 
 	"github.com/dsh2dsh/gofeed/v2/internal/shared"
 	"github.com/dsh2dsh/gofeed/v2/options"
@@ -19,8 +21,14 @@ type Parser struct {
 	opts        options.Parse
 	validReader ValidReader
 	err         error
+	warnings    []error
+	charset     string
+	stylesheet  string
 }
 
+// cdataCleaner removes residual CDATA markers from text.
+var cdataCleaner = strings.NewReplacer("<![CDATA[", "", "]]>", "")
+
 func NewParser(r io.Reader, opts ...options.Option) *Parser {
 	self := &Parser{}
 	return self.init(r, opts...)
@@ -29,36 +37,132 @@ func NewParser(r io.Reader, opts ...options.Option) *Parser {
 func (self *Parser) init(r io.Reader, opts ...options.Option) *Parser {
 	self.opts.Apply(opts...)
 
+	charsetReader := self.opts.CharsetReader
+	if self.opts.CharsetFallback || self.opts.Lenient {
+		charsetReader = self.fallbackCharsetReader(charsetReader)
+	}
+	charsetReader = self.detectCharsetReader(charsetReader)
+
 	if self.opts.StrictChars {
-		self.XMLPullParser = xpp.NewXMLPullParser(r, false, self.opts.CharsetReader)
+		self.XMLPullParser = xpp.NewXMLPullParser(r, false, charsetReader)
 	} else {
-		self.validReader.WithCharsetReader(self.opts.CharsetReader).WithReader(r)
+		self.validReader.WithCharsetReader(charsetReader).WithReader(r)
 		self.XMLPullParser = xpp.NewXMLPullParser(&self.validReader, false,
 			self.validReader.CharsetReader)
 	}
 	return self
 }
 
+func (self *Parser) fallbackCharsetReader(fn CharsetReaderFunc,
+) CharsetReaderFunc {
+	return func(label string, input io.Reader) (io.Reader, error) {
+		r, err := fn(label, input)
+		if err == nil {
+			return r, nil
+		}
+
+		self.warnings = append(self.warnings, fmt.Errorf(
+			"gofeed/internal/xml: decode charset=%q as latin1: %w", label, err))
+		return charset.NewReaderLabel("latin1", input)
+	}
+}
+
+func (self *Parser) detectCharsetReader(fn CharsetReaderFunc,
+) CharsetReaderFunc {
+	return func(label string, input io.Reader) (io.Reader, error) {
+		self.charset = strings.ToLower(label)
+		return fn(label, input)
+	}
+}
+
 func (self *Parser) Err() error { return self.err }
 
+// Charset returns charset of the document, declared by its prolog. It returns
+// "utf-8" if the document doesn't declare another charset.
+func (self *Parser) Charset() string {
+	if self.charset == "" {
+		return "utf-8"
+	}
+	return self.charset
+}
+
+// Warnings returns problems, which the parser worked around, like unsupported
+// charset.
+func (self *Parser) Warnings() []error { return self.warnings }
+
+// Warn records a problem, which doesn't prevent parsing of the feed.
+func (self *Parser) Warn(err error) { self.warnings = append(self.warnings, err) }
+
 // FindRoot iterates through the tokens of an xml document until it encounters
 // its first StartTag event. It returns an error if it reaches EndDocument
 // before finding a tag.
 func (self *Parser) FindRoot() (event xpp.XMLEventType, err error) {
 	for {
-		event, err = self.XMLPullParser.Next()
+		event, err = self.XMLPullParser.NextToken()
 		if err != nil {
 			return event, fmt.Errorf("gofeed/internal/xml: looking for root: %w", err)
 		}
 
-		if event == xpp.StartTag {
-			break
-		} else if event == xpp.EndDocument {
-			return event, errors.New(
-				"gofeed/internal/xml: failed to find root node before document end")
+		switch event {
+		case xpp.StartTag:
+			return event, nil
+		case xpp.EndDocument:
+			return event, shared.WithCategory(errors.New(
+				"gofeed/internal/xml: failed to find root node before document end"),
+				shared.ErrNoRootElement)
+		case xpp.ProcessingInstruction:
+			if self.opts.KeepStylesheet {
+				self.procInst()
+			}
+		}
+	}
+}
+
+// procInst remembers href of the first xml-stylesheet processing instruction.
+func (self *Parser) procInst() {
+	target, inst, _ := strings.Cut(self.XMLPullParser.Text(), " ")
+	if target != "xml-stylesheet" || self.stylesheet != "" {
+		return
+	}
+
+	// Pseudo-attributes of processing instruction have the same syntax as
+	// attributes of an element.
+	d := stdxml.NewDecoder(strings.NewReader("<pi " + inst + "/>"))
+	t, err := d.Token()
+	if err != nil {
+		return
+	}
+
+	if start, ok := t.(stdxml.StartElement); ok {
+		for _, attr := range start.Attr {
+			if attr.Name.Local == "href" {
+				self.stylesheet = strings.TrimSpace(attr.Value)
+				return
+			}
 		}
 	}
-	return event, nil
+}
+
+// Lenient returns true if the parser is configured with [options.WithLenient].
+func (self *Parser) Lenient() bool { return self.opts.Lenient }
+
+// Stylesheet returns href of xml-stylesheet processing instruction, found
+// before the root element with [options.WithKeepStylesheet].
+func (self *Parser) Stylesheet() string { return self.stylesheet }
+
+// CategorizeErr adds category of err, like [shared.ErrInvalidXML], which can be
+// checked by [errors.Is]. Message of err is kept as is.
+func CategorizeErr(err error) error {
+	var syntaxErr *stdxml.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return err
+	}
+
+	if strings.Contains(syntaxErr.Msg, "unexpected EOF") {
+		return shared.WithCategory(err, shared.ErrInvalidXML,
+			shared.ErrUnexpectedEndOfDocument)
+	}
+	return shared.WithCategory(err, shared.ErrInvalidXML)
 }
 
 // Text is a helper function for parsing the text from the current element of
@@ -69,6 +173,42 @@ func (self *Parser) Text() string {
 		self.err = fmt.Errorf("gofeed/internal/xml: parse text: %w", err)
 		return ""
 	}
+
+	if self.opts.CleanCDATA {
+		s = cdataCleaner.Replace(s)
+	}
+	return strings.TrimSpace(s)
+}
+
+// DeepText is like [Parser.Text], but it doesn't fail on child elements of the
+// current element, and returns concatenated text of all its descendants.
+func (self *Parser) DeepText() string {
+	var sb strings.Builder
+	for depth := 1; depth > 0; {
+		event, err := self.XMLPullParser.Next()
+		if err != nil {
+			self.err = fmt.Errorf("gofeed/internal/xml: parse deep text: %w", err)
+			return ""
+		}
+
+		switch event {
+		case xpp.StartTag:
+			depth++
+		case xpp.EndTag:
+			depth--
+		case xpp.Text:
+			sb.WriteString(self.XMLPullParser.Text())
+		case xpp.EndDocument:
+			self.err = errors.New(
+				"gofeed/internal/xml: parse deep text: unexpected end of the document")
+			return ""
+		}
+	}
+
+	s := sb.String()
+	if self.opts.CleanCDATA {
+		s = cdataCleaner.Replace(s)
+	}
 	return strings.TrimSpace(s)
 }
 
@@ -92,8 +232,20 @@ func (self *Parser) Skip(tag string) {
 	}
 }
 
+// SkipRest skips remaining children of element name, when current event is end
+// of its child, and consumes end tag of the element.
+func (self *Parser) SkipRest(name string) error {
+	if self.Skip(name); self.err != nil {
+		return self.err
+	}
+	return self.Expect(xpp.EndTag, name)
+}
+
 func (self *Parser) Expect(event xpp.XMLEventType, name string) error {
 	if err := self.XMLPullParser.Expect(event, name); err != nil {
+		if self.Event == event && strings.EqualFold(self.mapName(), name) {
+			return nil
+		}
 		return fmt.Errorf("gofeed/internal/xml: expect %q tag, got %q: %w",
 			name, self.Name, err)
 	}
@@ -108,6 +260,8 @@ func (self *Parser) Expect(event xpp.XMLEventType, name string) error {
 func (self *Parser) Next() (xpp.XMLEventType, error) {
 	if self.err != nil {
 		return 0, self.err
+	} else if ctx := self.opts.Context; ctx != nil && ctx.Err() != nil {
+		return 0, fmt.Errorf("gofeed/internal/xml: stop parsing: %w", ctx.Err())
 	}
 
 	for {
@@ -154,6 +308,24 @@ func (self *Parser) WithText(name string, init func() error,
 	return self.Expect(xpp.EndTag, name)
 }
 
+// Resource returns value of rdf:resource attribute of current element, or its
+// text, if the element doesn't have this attribute.
+func (self *Parser) Resource(name string) (string, error) {
+	var resource string
+	err := self.WithText(name,
+		func() error {
+			resource = self.Attribute("resource")
+			return nil
+		},
+		func(s string) error {
+			if resource == "" {
+				resource = s
+			}
+			return nil
+		})
+	return resource, err
+}
+
 func (self *Parser) MakeChildrenSeq(name string) (iter.Seq[string], error) {
 	if err := self.Expect(xpp.StartTag, name); err != nil {
 		return nil, err
@@ -171,13 +343,22 @@ func (self *Parser) MakeChildrenSeq(name string) (iter.Seq[string], error) {
 					self.err = self.Expect(xpp.EndTag, name)
 				}
 				return
-			case !yield(strings.ToLower(self.Name)):
+			case !yield(strings.ToLower(self.mapName())):
 				return
 			}
 		}
 	}, nil
 }
 
+// mapName returns name of current element, mapped by
+// [options.Parse.ElementNameMapper], if it's configured.
+func (self *Parser) mapName() string {
+	if self.opts.ElementNameMapper == nil {
+		return self.Name
+	}
+	return self.opts.ElementNameMapper(self.Name)
+}
+
 func (self *Parser) AttributeSeq() iter.Seq2[string, string] {
 	return func(yield func(string, string) bool) {
 		for i := range self.Attrs {
@@ -215,6 +396,16 @@ func (self *Parser) ExtensionPrefix() string {
 	return ns
 }
 
+// UndeclaredNamespace returns true if current element has no namespace, or its
+// namespace prefix wasn't declared by the document.
+func (self *Parser) UndeclaredNamespace() bool {
+	// Go's XML decoder keeps undeclared prefix as is, instead of namespace URI.
+	return !strings.Contains(self.Space, ":")
+}
+
 func (self *Parser) NamespacePrefix() string {
+	if self.opts.StrictNamespaces {
+		return self.Spaces[self.Space]
+	}
 	return shared.PrefixForNamespace(self.Space, self.XMLPullParser)
 }
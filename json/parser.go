@@ -1,11 +1,14 @@
 package json
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 
+	"github.com/dsh2dsh/gofeed/v2/internal/shared"
 	"github.com/dsh2dsh/gofeed/v2/options"
 )
 
@@ -17,13 +20,49 @@ func NewParser() *Parser { return &Parser{} }
 
 // Parse parses an json feed into an json.Feed
 func (ap *Parser) Parse(r io.Reader, opts ...options.Option) (*Feed, error) {
+	var parseOpts options.Parse
+	parseOpts.Apply(opts...)
+	if ctx := parseOpts.Context; ctx != nil {
+		r = &contextReader{ctx: ctx, r: r}
+	}
+
 	feed := &Feed{}
 	if err := json.NewDecoder(r).Decode(feed); err != nil {
-		return nil, fmt.Errorf("gofeed/json: unable unmarshal feed: %w", err)
+		return nil, categorizeErr(fmt.Errorf(
+			"gofeed/json: unable unmarshal feed: %w", err))
 	}
 	return feed, nil
 }
 
+// contextReader is an [io.Reader], which stops reading after its context is
+// done. It checks the context before every read, so the decoder stops between
+// reads.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (self *contextReader) Read(p []byte) (int, error) {
+	if err := self.ctx.Err(); err != nil {
+		return 0, fmt.Errorf("gofeed/json: stop parsing: %w", err)
+	}
+	return self.r.Read(p)
+}
+
+// categorizeErr adds category of err, like [shared.ErrInvalidJSON], which can
+// be checked by [errors.Is].
+func categorizeErr(err error) error {
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return shared.WithCategory(err, shared.ErrInvalidJSON,
+			shared.ErrUnexpectedEndOfDocument)
+	case errors.As(err, &syntaxErr):
+		return shared.WithCategory(err, shared.ErrInvalidJSON)
+	}
+	return err
+}
+
 var _ json.Unmarshaler = (*Feed)(nil)
 
 func (self *Feed) UnmarshalJSON(b []byte) error {
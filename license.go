@@ -0,0 +1,84 @@
+package gofeed
+
+import (
+	"net/url"
+	"strings"
+)
+
+// License returns URL of the license of the item and permissions, inferred
+// from Creative Commons license URL, like
+// "https://creativecommons.org/licenses/by-nc-sa/4.0/". The URL comes from
+// <creativeCommons:license>, <cc:license> or a link with rel="license".
+// Permissions of CC0 and public domain mark require no attribution and allow
+// commercial use. Both permissions are false for unknown licenses.
+func (i *Item) License() (licenseURL string, requiresAttribution,
+	allowsCommercial bool,
+) {
+	licenseURL = i.licenseURL()
+	if licenseURL == "" {
+		return "", false, false
+	}
+	requiresAttribution, allowsCommercial = ccPermissions(licenseURL)
+	return licenseURL, requiresAttribution, allowsCommercial
+}
+
+func (i *Item) licenseURL() string {
+	for _, e := range i.GetExtension("creativeCommons", "license") {
+		if s := strings.TrimSpace(e.Value); s != "" {
+			return s
+		}
+	}
+
+	for _, e := range i.GetExtension("cc", "license") {
+		if s := strings.TrimSpace(e.Attrs["resource"]); s != "" {
+			return s
+		} else if s := strings.TrimSpace(e.Value); s != "" {
+			return s
+		}
+	}
+
+	for _, l := range i.LinkDetails {
+		if strings.EqualFold(l.Rel, "license") && l.Href != "" {
+			return l.Href
+		}
+	}
+	return ""
+}
+
+// ccPermissions infers permissions from path of Creative Commons license URL,
+// like "/licenses/by-nc/4.0/" or "/publicdomain/zero/1.0/".
+func ccPermissions(licenseURL string) (requiresAttribution,
+	allowsCommercial bool,
+) {
+	u, err := url.Parse(licenseURL)
+	if err != nil {
+		return false, false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if host != "creativecommons.org" {
+		return false, false
+	}
+
+	parts := strings.Split(strings.Trim(strings.ToLower(u.Path), "/"), "/")
+	if len(parts) < 2 {
+		return false, false
+	}
+
+	switch parts[0] {
+	case "publicdomain":
+		return false, true
+	case "licenses":
+		terms := strings.Split(parts[1], "-")
+		if terms[0] != "by" {
+			return false, false
+		}
+		for _, term := range terms[1:] {
+			if term == "nc" {
+				return true, false
+			}
+		}
+		return true, true
+	}
+	return false, false
+}
@@ -0,0 +1,16 @@
+package gofeed
+
+import "strings"
+
+// mergeContentDescription prepends Description of items to their Content, if
+// both are present and Content doesn't start with Description already.
+func mergeContentDescription(feed *Feed) {
+	for _, item := range feed.Items {
+		desc := strings.TrimSpace(item.Description)
+		content := strings.TrimSpace(item.Content)
+		if desc == "" || content == "" || strings.HasPrefix(content, desc) {
+			continue
+		}
+		item.Content = desc + "\n" + content
+	}
+}
@@ -1,7 +1,9 @@
 package options
 
 import (
+	"context"
 	"io"
+	"time"
 
 	"golang.org/x/net/html/charset"
 )
@@ -19,20 +21,182 @@ type Parse struct {
 	// charset-conversion readers, converting from the provided non-UTF-8 charset
 	// into UTF-8. If CharsetReader is nil or returns an error, parsing stops with
 	// an error. One of the CharsetReader's result values must be non-nil.
+	//
+	// The default reader decodes ISO-8859-1 (latin1) as Windows-1252, like
+	// browsers do, so smart quotes and dashes of feeds with mislabeled charset
+	// are preserved, instead of turned into control characters.
 	CharsetReader func(charset string, input io.Reader) (io.Reader, error)
 
+	// Skip unrecognized default-namespace elements, instead of parse them into
+	// "_custom" namespace of [ext.Extensions] map.
+	SkipCustomExtensions bool
+
+	// Collect unrecognized default-namespace elements into a separate
+	// UnknownElements slice of feed and items, instead of "_custom" extensions.
+	UnknownElementsSeparate bool
+
+	// Setting CharsetFallback to true makes the parser decode the feed as
+	// latin1 (as Windows-1252), if CharsetReader returns an error for declared
+	// charset, instead of stop parsing with an error.
+	CharsetFallback bool
+
+	// Setting Lenient to true enables workarounds for common errors of broken
+	// feeds, which can give false positives for valid feeds. It also enables
+	// CharsetFallback. Missing id of Atom entries is synthesized from their links.
+	// Media prices, which aren't numbers, are skipped.
+	Lenient bool
+
 	// Setting StrictChars to true disables filtering of invalid UTF-8 or XML
 	// characters. Parser will work faster, but XML decoder will return an error
 	// if it detects such character.
 	StrictChars bool
+
+	// Resolve relative links and enclosure URLs of items against home page URL
+	// of the feed, during translation into the universal feed.
+	ResolveRelativeLinks bool
+
+	// Skip RSS items and Atom entries, which were published or updated not after
+	// ItemsSince. The rest of an item is skipped without parsing, as soon as its
+	// date is parsed and isn't after ItemsSince, unless its date parsed before
+	// is after ItemsSince. Items without parsable dates are kept. Zero value
+	// keeps all items.
+	ItemsSince time.Time
+
+	// FetchedURL is the final URL of the feed, after all redirects, like
+	// resp.Request.URL of [net/http.Response]. The universal parser exposes it
+	// as FetchedURL of the feed and uses it as the base URL for resolving of
+	// relative links, if ResolveRelativeLinks is enabled. FetchedURL given to
+	// Parse or ParseWithType of the universal parser applies to that call only.
+	FetchedURL string
+
+	// Setting Validate to true makes the parser check the feed for problems,
+	// which don't prevent its parsing, like image link, which doesn't match
+	// channel link. Found problems are reported as warnings of the parser.
+	Validate bool
+
+	// Setting DetectEmbedded to true makes feed detection look for RSS or Atom
+	// feed, embedded into HTML page, if root element of the document is <html>.
+	// Only the beginning of the page is scanned.
+	DetectEmbedded bool
+
+	// Setting Streaming to true makes the universal parser detect type of the
+	// feed by its beginning only and parse the feed while reading it, instead of
+	// reading the whole feed into memory first. It reduces latency of parsing of
+	// large feeds, read from slow connection. JSON feeds aren't validated during
+	// detection, so malformed JSON is reported by JSON parser.
+	Streaming bool
+
+	// Timeout limits duration of parsing by the universal parser. The parser
+	// stops parsing of the feed after the timeout and returns an error, which
+	// wraps [context.DeadlineExceeded], even if a read of the feed is blocked.
+	// Such blocked read is left in the background, until the reader of the
+	// feed returns, so the caller should close it, like the response body.
+	// Zero means no limit.
+	Timeout time.Duration
+
+	// Context stops parsing of the feed, when it's done, with an error, which
+	// wraps error of the context. XML feeds check it before every element and
+	// JSON feeds before every read. The universal parser derives its own
+	// context with Timeout from it.
+	Context context.Context
+
+	// Setting StripControlEntities to true makes the universal parser remove
+	// control characters, except tab, newline and carriage return, and numeric
+	// character references to them, like "&#12;", from text fields of the feed
+	// and its items. Such characters survive parsing in CDATA sections and in
+	// JSON feeds, and break downstream renderers or databases.
+	StripControlEntities bool
+
+	// Setting MergeContentDescription to true makes the universal parser
+	// prepend Description of items to their Content, if both are present and
+	// differ, unless Content already starts with Description. It gives the
+	// fullest body for feeds, which split a lead paragraph into description and
+	// the rest of the article into content:encoded.
+	MergeContentDescription bool
+
+	// ElementNameMapper, if non-nil, remaps names of child elements, before the
+	// parser lowercases and recognizes them, like "pubDate2" to "pubDate". It
+	// allows to parse feeds with nonstandard element names.
+	ElementNameMapper func(name string) string
+
+	// Setting CleanCDATA to true makes the parser remove residual "<![CDATA["
+	// and "]]>" markers from text of elements, which are left by feeds with
+	// double-wrapped or escaped CDATA sections.
+	CleanCDATA bool
+
+	// Setting StrictNamespaces to true makes the parser recognize only
+	// namespaces, declared by the feed itself, with prefixes declared by the
+	// feed. Elements with undeclared namespace prefix are parsed like
+	// unrecognized elements without namespace. By default canonical prefixes of
+	// well known namespaces override declared prefixes, and undeclared prefixes
+	// are used as is.
+	StrictNamespaces bool
+
+	// RequireElements lists feed-level elements, like "title" or "link", which
+	// must be present in the feed. The universal parser returns an error if any
+	// of them is missing. Names are matched case-insensitively against known
+	// elements of the feed type and unrecognized default-namespace elements.
+	RequireElements []string
+
+	// Populate DetectedCharset of the universal feed with charset, declared by
+	// the feed.
+	DetectedCharset bool
+
+	// Populate Stylesheet of the universal feed with href of xml-stylesheet
+	// processing instruction from XML prolog of the feed.
+	KeepStylesheet bool
+
+	// ContentFallbackChain defines order of sources of Content and Description
+	// of universal items, for RSS, Atom and JSON feeds. Description is the
+	// first non-empty source, except [ContentSourceContent]. Content is the
+	// first non-empty source, starting from [ContentSourceContent], and it's
+	// filled by the chain only if the chain contains [ContentSourceContent].
+	// Empty chain keeps defaults of every feed type. For RSS items it's
+	// [DefaultContentFallbackChain].
+	ContentFallbackChain []ContentSource
 }
 
 type Option func(opts *Parse)
 
+// ContentSource is a source of item content or description, used by
+// [WithContentFallbackChain].
+type ContentSource int
+
+const (
+	// ContentSourceContent is <content:encoded> or <atom:content> of RSS item,
+	// <content> of Atom entry, or content_html or content_text of JSON item.
+	ContentSourceContent ContentSource = iota + 1
+	// ContentSourceDescription is <description> of RSS item, <summary> of Atom
+	// entry, or summary of JSON item.
+	ContentSourceDescription
+	// ContentSourceDublinCore is <dc:description> of RSS item.
+	ContentSourceDublinCore
+	// ContentSourceAtom is <atom:summary> of RSS item, or <summary> of Atom
+	// entry.
+	ContentSourceAtom
+	// ContentSourceITunes is <itunes:summary> or <itunes:subtitle> of RSS item.
+	ContentSourceITunes
+	// ContentSourceMedia is <media:description> of RSS item or Atom entry.
+	ContentSourceMedia
+)
+
+// DefaultContentFallbackChain is the order of sources of RSS item description,
+// which is used by default. RSS item content isn't filled from other sources
+// by default.
+var DefaultContentFallbackChain = []ContentSource{
+	ContentSourceDescription,
+	ContentSourceDublinCore,
+	ContentSourceAtom,
+	ContentSourceITunes,
+	ContentSourceMedia,
+}
+
 // Apply applies every option from array of opts and returns self ref.
 func (self *Parse) Apply(opts ...Option) *Parse {
 	for _, fn := range opts {
-		fn(self)
+		if fn != nil {
+			fn(self)
+		}
 	}
 
 	if self.CharsetReader == nil {
@@ -68,8 +232,147 @@ func WithCharsetReader(
 	return func(opts *Parse) { opts.CharsetReader = fn }
 }
 
+// WithCharsetFallback configures the parser to decode the feed as latin1, if
+// declared charset isn't supported. See [Parse.CharsetFallback] for details.
+func WithCharsetFallback(v bool) Option {
+	return func(opts *Parse) { opts.CharsetFallback = v }
+}
+
+// WithLenient configures the parser to work around common errors of broken
+// feeds. See [Parse.Lenient] for details.
+func WithLenient(v bool) Option {
+	return func(opts *Parse) { opts.Lenient = v }
+}
+
 // WithStrictChars configures parser don't skip invalid UTF-8 or XML characters.
 // See [Parse.StrictChars] for details.
 func WithStrictChars(v bool) Option {
 	return func(opts *Parse) { opts.StrictChars = v }
 }
+
+// WithUnknownElementsSeparate configures the parser to collect unrecognized
+// default-namespace elements into UnknownElements of feed and items, instead
+// of "_custom" namespace of extensions. By default they go into extensions.
+func WithUnknownElementsSeparate(v bool) Option {
+	return func(opts *Parse) { opts.UnknownElementsSeparate = v }
+}
+
+// WithoutCustomExtensions configures the parser to skip unrecognized
+// default-namespace elements, instead of parse them into "_custom" namespace
+// of [ext.Extensions] map. Unlike [WithSkipUnknownElements], it doesn't affect
+// extensions from other namespaces.
+func WithoutCustomExtensions(v bool) Option {
+	return func(opts *Parse) { opts.SkipCustomExtensions = v }
+}
+
+// WithResolveRelativeLinks configures the universal parser to resolve relative
+// links and enclosure URLs of items against home page URL of the feed. By
+// default links are kept as is.
+func WithResolveRelativeLinks(v bool) Option {
+	return func(opts *Parse) { opts.ResolveRelativeLinks = v }
+}
+
+// WithFetchedURL configures the universal parser with the final URL of the
+// feed, after all redirects. See [Parse.FetchedURL] for details.
+func WithFetchedURL(u string) Option {
+	return func(opts *Parse) { opts.FetchedURL = u }
+}
+
+// WithItemsSince configures the parser to skip items, which were published or
+// updated not after t. See [Parse.ItemsSince] for details.
+func WithItemsSince(t time.Time) Option {
+	return func(opts *Parse) { opts.ItemsSince = t }
+}
+
+// WithDetectedCharset configures the universal parser to populate
+// DetectedCharset of parsed feed with charset, declared by XML prolog of the
+// feed, or "utf-8" if it doesn't declare another charset. JSON feeds always
+// get "utf-8".
+func WithDetectedCharset(v bool) Option {
+	return func(opts *Parse) { opts.DetectedCharset = v }
+}
+
+// WithKeepStylesheet configures the universal parser to populate Stylesheet of
+// parsed feed with href of <?xml-stylesheet?> processing instruction, which
+// precedes the root element of XML feed.
+func WithKeepStylesheet(v bool) Option {
+	return func(opts *Parse) { opts.KeepStylesheet = v }
+}
+
+// WithContentFallbackChain configures the universal parser to fill content and
+// description of items from the first non-empty source of given sources, in
+// given order. Like WithContentFallbackChain(ContentSourceContent,
+// ContentSourceDescription, ContentSourceITunes, ContentSourceMedia) for
+// content, which falls back to description. See [Parse.ContentFallbackChain]
+// for details.
+func WithContentFallbackChain(sources ...ContentSource) Option {
+	return func(opts *Parse) { opts.ContentFallbackChain = sources }
+}
+
+// WithValidate configures the parser to check the feed for problems, which
+// don't prevent its parsing. See [Parse.Validate] for details.
+func WithValidate(v bool) Option {
+	return func(opts *Parse) { opts.Validate = v }
+}
+
+// WithDetectEmbedded configures feed detection to look for RSS or Atom feed,
+// embedded into HTML page. See [Parse.DetectEmbedded] for details.
+func WithDetectEmbedded(v bool) Option {
+	return func(opts *Parse) { opts.DetectEmbedded = v }
+}
+
+// WithStreaming configures the universal parser to parse the feed while
+// reading it. See [Parse.Streaming] for details.
+func WithStreaming(v bool) Option {
+	return func(opts *Parse) { opts.Streaming = v }
+}
+
+// WithTimeout configures the universal parser to stop parsing of the feed
+// after d. See [Parse.Timeout] for details.
+func WithTimeout(d time.Duration) Option {
+	return func(opts *Parse) { opts.Timeout = d }
+}
+
+// WithContext configures the parser to stop parsing of the feed, when ctx is
+// done. See [Parse.Context] for details.
+func WithContext(ctx context.Context) Option {
+	return func(opts *Parse) { opts.Context = ctx }
+}
+
+// WithStripControlEntities configures the universal parser to remove control
+// characters from text fields. See [Parse.StripControlEntities] for details.
+func WithStripControlEntities(v bool) Option {
+	return func(opts *Parse) { opts.StripControlEntities = v }
+}
+
+// WithMergeContentDescription configures the universal parser to merge
+// description of items into their content. See [Parse.MergeContentDescription]
+// for details.
+func WithMergeContentDescription(v bool) Option {
+	return func(opts *Parse) { opts.MergeContentDescription = v }
+}
+
+// WithElementNameMapper configures the parser to remap names of child
+// elements by fn. See [Parse.ElementNameMapper] for details.
+func WithElementNameMapper(fn func(name string) string) Option {
+	return func(opts *Parse) { opts.ElementNameMapper = fn }
+}
+
+// WithCleanCDATA configures the parser to remove residual CDATA markers from
+// text of elements. See [Parse.CleanCDATA] for details.
+func WithCleanCDATA(v bool) Option {
+	return func(opts *Parse) { opts.CleanCDATA = v }
+}
+
+// WithStrictNamespaces configures the parser to recognize only namespaces,
+// declared by the feed. See [Parse.StrictNamespaces] for details.
+func WithStrictNamespaces(v bool) Option {
+	return func(opts *Parse) { opts.StrictNamespaces = v }
+}
+
+// WithRequireElements configures the universal parser to return an error if
+// the feed lacks any of given feed-level elements. See [Parse.RequireElements]
+// for details.
+func WithRequireElements(elements ...string) Option {
+	return func(opts *Parse) { opts.RequireElements = elements }
+}
@@ -0,0 +1,28 @@
+package gofeed
+
+import (
+	"github.com/dsh2dsh/gofeed/v2/atom"
+	"github.com/dsh2dsh/gofeed/v2/json"
+	"github.com/dsh2dsh/gofeed/v2/rss"
+)
+
+// RSSFeed returns OriginalFeed as RSS feed and true, or false if the feed
+// wasn't parsed from RSS or [options.WithKeepOriginalFeed] wasn't set.
+func (f *Feed) RSSFeed() (*rss.Feed, bool) {
+	feed, ok := f.OriginalFeed.(*rss.Feed)
+	return feed, ok && feed != nil
+}
+
+// AtomFeed returns OriginalFeed as Atom feed and true, or false if the feed
+// wasn't parsed from Atom or [options.WithKeepOriginalFeed] wasn't set.
+func (f *Feed) AtomFeed() (*atom.Feed, bool) {
+	feed, ok := f.OriginalFeed.(*atom.Feed)
+	return feed, ok && feed != nil
+}
+
+// JSONFeed returns OriginalFeed as JSON feed and true, or false if the feed
+// wasn't parsed from JSON or [options.WithKeepOriginalFeed] wasn't set.
+func (f *Feed) JSONFeed() (*json.Feed, bool) {
+	feed, ok := f.OriginalFeed.(*json.Feed)
+	return feed, ok && feed != nil
+}
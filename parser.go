@@ -2,66 +2,185 @@ package gofeed
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/dsh2dsh/gofeed/v2/atom"
+	"github.com/dsh2dsh/gofeed/v2/internal/shared"
 	"github.com/dsh2dsh/gofeed/v2/json"
 	"github.com/dsh2dsh/gofeed/v2/options"
 	"github.com/dsh2dsh/gofeed/v2/rss"
 )
 
-// ErrFeedTypeNotDetected is returned when the detection system can not figure
-// out the Feed format
-var ErrFeedTypeNotDetected = errors.New("failed to detect feed type")
+var (
+	// ErrFeedTypeNotDetected is returned when the detection system can not
+	// figure out the Feed format
+	ErrFeedTypeNotDetected = errors.New("failed to detect feed type")
+
+	// ErrMissingRequiredElement is returned when the feed lacks an element,
+	// required by [options.WithRequireElements].
+	ErrMissingRequiredElement = errors.New("missing required element")
+
+	// ErrInvalidDataURI is returned by [ParseDataURI] for malformed data: URI.
+	ErrInvalidDataURI = errors.New("invalid data URI")
+
+	// ErrInvalidXML matches errors of malformed XML feeds.
+	ErrInvalidXML = shared.ErrInvalidXML
+
+	// ErrInvalidJSON matches errors of malformed JSON feeds.
+	ErrInvalidJSON = shared.ErrInvalidJSON
+
+	// ErrUnexpectedEndOfDocument matches errors of truncated feeds.
+	ErrUnexpectedEndOfDocument = shared.ErrUnexpectedEndOfDocument
+
+	// ErrNoRootElement matches errors of XML documents without root element.
+	ErrNoRootElement = shared.ErrNoRootElement
+)
 
 // Parser is a universal feed parser that detects
 // a given feed type, parsers it, and translates it
 // to the universal feed type.
+//
+// Options, given to Parse or ParseWithType, are kept by the parser and apply
+// to the next calls too, until [Parser.Reset], except FetchedURL, which applies
+// to one call only. Parser is safe for concurrent use only by calls without
+// options.
 type Parser struct {
 	AtomTranslator Translator
 	RSSTranslator  Translator
 	JSONTranslator Translator
 
-	opts options.Parse
+	opts     options.Parse
+	initOpts options.Parse
 }
 
 // NewParser creates a universal feed parser.
 func NewParser(opts ...options.Option) *Parser {
 	p := &Parser{}
-	return p.init()
+	return p.init(opts...)
 }
 
 func (f *Parser) init(opts ...options.Option) *Parser {
 	f.opts.Apply(opts...)
+	f.initOpts = f.opts
 	return f
 }
 
+// Reset drops options, given to previous calls of Parse or ParseWithType, and
+// restores options, given to [NewParser]. It allows to reuse pooled parser
+// without leaking options from one feed to another. Reset must not be called
+// concurrently with parsing.
+func (f *Parser) Reset() { f.opts = f.initOpts }
+
 // Parse parses a RSS or Atom or JSON feed into the universal gofeed.Feed. It
-// takes an io.Reader which should return the xml/json content.
+// takes an io.Reader which should return the xml/json content. The parser
+// doesn't detect compression, so compressed feed must be wrapped into
+// decompressing reader, like [compress/gzip.Reader], by the caller. Any reader
+// works, including readers, which return partial reads or data together with
+// io.EOF. The feed is read until io.EOF.
 func (f *Parser) Parse(feed io.Reader, opts ...options.Option) (*Feed, error) {
 	f.opts.Apply(opts...)
+	defer f.restoreFetchedURL()
+	ctx, cancel := f.timeoutContext()
+	defer cancel()
+	feed = withContext(ctx, feed)
+
+	if f.opts.Streaming {
+		feedType, r, err := detectFeedReader(feed, &f.opts)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrFeedTypeNotDetected, err)
+		}
+		return f.parseFeedType(ctx, r, feedType)
+	}
 
 	var buf bytes.Buffer
 	if _, err := buf.ReadFrom(feed); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFeedTypeNotDetected, err)
 	}
-	feedType := DetectFeedBytes(buf.Bytes())
+	feedType, b := detectFeed(buf.Bytes(), &f.opts)
+	// The feed is in memory already, so parsers check ctx by themselves.
+	return f.parseFeedType(ctx, bytes.NewReader(b), feedType)
+}
 
+// ParseWithType parses a feed of given type into the universal gofeed.Feed. It
+// skips detection of the feed type and reads the feed directly by the parser
+// for given type, so it returns an error if the feed has another type.
+func (f *Parser) ParseWithType(feed io.Reader, feedType FeedType,
+	opts ...options.Option,
+) (*Feed, error) {
+	f.opts.Apply(opts...)
+	defer f.restoreFetchedURL()
+	ctx, cancel := f.timeoutContext()
+	defer cancel()
+	return f.parseFeedType(ctx, withContext(ctx, feed), feedType)
+}
+
+// restoreFetchedURL restores FetchedURL, given to [NewParser], after parsing of
+// a feed. FetchedURL given to Parse or ParseWithType belongs to that feed only
+// and must not be reported by, or resolve links of, the next parsed feed.
+// Parser without options is used concurrently, so it's written only if changed.
+func (f *Parser) restoreFetchedURL() {
+	if f.opts.FetchedURL != f.initOpts.FetchedURL {
+		f.opts.FetchedURL = f.initOpts.FetchedURL
+	}
+}
+
+// timeoutContext returns [options.Parse.Context], which is canceled after
+// [options.Parse.Timeout], if it's configured.
+func (f *Parser) timeoutContext() (context.Context, context.CancelFunc) {
+	ctx := f.opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if f.opts.Timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, f.opts.Timeout)
+}
+
+func (f *Parser) parseFeedType(ctx context.Context, r io.Reader,
+	feedType FeedType,
+) (*Feed, error) {
+	var feed *Feed
+	var err error
 	switch feedType {
 	case FeedTypeAtom:
-		return f.parseAtomFeed(&buf)
+		feed, err = f.parseAtomFeed(ctx, r)
 	case FeedTypeRSS:
-		return f.parseRSSFeed(&buf)
+		feed, err = f.parseRSSFeed(ctx, r)
 	case FeedTypeJSON:
-		return f.parseJSONFeed(&buf)
+		feed, err = f.parseJSONFeed(ctx, r)
+	default:
+		return nil, ErrFeedTypeNotDetected
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	feed.FetchedURL = f.opts.FetchedURL
+
+	if f.opts.StripControlEntities {
+		stripControlChars(feed)
+	}
+	if f.opts.MergeContentDescription {
+		mergeContentDescription(feed)
 	}
-	return nil, ErrFeedTypeNotDetected
+	return feed, nil
 }
 
-func (f *Parser) parseAtomFeed(feed io.Reader) (*Feed, error) {
-	af, err := atom.NewParser().Parse(feed, options.From(f.opts))
+func (f *Parser) parseAtomFeed(ctx context.Context, feed io.Reader,
+) (*Feed, error) {
+	p := atom.NewParser()
+	af, err := p.Parse(feed, options.From(f.opts), options.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireElements(f.opts.RequireElements,
+		func(name string) bool { return atomHasElement(af, name) })
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +194,12 @@ func (f *Parser) parseAtomFeed(feed io.Reader) (*Feed, error) {
 	if err != nil {
 		return nil, fmt.Errorf("gofeed: translate atom: %w", err)
 	}
+	if f.opts.DetectedCharset {
+		result.DetectedCharset = p.Charset()
+	}
+	if f.opts.KeepStylesheet {
+		result.Stylesheet = p.Stylesheet()
+	}
 
 	if f.keepOriginalFeed() {
 		result.OriginalFeed = af
@@ -84,8 +209,16 @@ func (f *Parser) parseAtomFeed(feed io.Reader) (*Feed, error) {
 
 func (f *Parser) keepOriginalFeed() bool { return f.opts.KeepOriginalFeed }
 
-func (f *Parser) parseRSSFeed(feed io.Reader) (*Feed, error) {
-	rf, err := rss.NewParser().Parse(feed, options.From(f.opts))
+func (f *Parser) parseRSSFeed(ctx context.Context, feed io.Reader,
+) (*Feed, error) {
+	p := rss.NewParser()
+	rf, err := p.Parse(feed, options.From(f.opts), options.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireElements(f.opts.RequireElements,
+		func(name string) bool { return rssHasElement(rf, name) })
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +232,12 @@ func (f *Parser) parseRSSFeed(feed io.Reader) (*Feed, error) {
 	if err != nil {
 		return nil, fmt.Errorf("gofeed: translate rss: %w", err)
 	}
+	if f.opts.DetectedCharset {
+		result.DetectedCharset = p.Charset()
+	}
+	if f.opts.KeepStylesheet {
+		result.Stylesheet = p.Stylesheet()
+	}
 
 	if f.keepOriginalFeed() {
 		result.OriginalFeed = rf
@@ -106,8 +245,16 @@ func (f *Parser) parseRSSFeed(feed io.Reader) (*Feed, error) {
 	return result, nil
 }
 
-func (f *Parser) parseJSONFeed(feed io.Reader) (*Feed, error) {
-	jf, err := json.NewParser().Parse(feed, options.From(f.opts))
+func (f *Parser) parseJSONFeed(ctx context.Context, feed io.Reader,
+) (*Feed, error) {
+	jf, err := json.NewParser().Parse(feed, options.From(f.opts),
+		options.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireElements(f.opts.RequireElements,
+		func(name string) bool { return jsonHasElement(jf, name) })
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +268,9 @@ func (f *Parser) parseJSONFeed(feed io.Reader) (*Feed, error) {
 	if err != nil {
 		return nil, fmt.Errorf("gofeed: translate json: %w", err)
 	}
+	if f.opts.DetectedCharset {
+		result.DetectedCharset = "utf-8"
+	}
 
 	if f.keepOriginalFeed() {
 		result.OriginalFeed = jf
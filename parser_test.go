@@ -1,7 +1,11 @@
 package gofeed_test
 
 import (
+	"bufio"
 	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -11,6 +15,7 @@ import (
 	"sync"
 	"testing"
 	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -139,6 +144,225 @@ func TestParserKeepOriginalFeed(t *testing.T) {
 	assert.Equal(t, "t", orig.Title, "original feed title")
 }
 
+func TestFeed_OriginalFeedAccessors(t *testing.T) {
+	tests := []struct {
+		name     string
+		feed     string
+		feedType string
+	}{
+		{
+			name:     "rss",
+			feed:     `<rss version="2.0"><channel><title>t</title></channel></rss>`,
+			feedType: "rss",
+		},
+		{
+			name:     "atom",
+			feed:     `<feed xmlns="http://www.w3.org/2005/Atom"><title>t</title></feed>`,
+			feedType: "atom",
+		},
+		{
+			name:     "json",
+			feed:     `{"version": "https://jsonfeed.org/version/1.1", "title": "t"}`,
+			feedType: "json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser(options.WithKeepOriginalFeed(true)).
+				Parse(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			require.Equal(t, tt.feedType, feed.FeedType)
+
+			rssFeed, ok := feed.RSSFeed()
+			assert.Equal(t, tt.feedType == "rss", ok)
+			atomFeed, ok := feed.AtomFeed()
+			assert.Equal(t, tt.feedType == "atom", ok)
+			jsonFeed, ok := feed.JSONFeed()
+			assert.Equal(t, tt.feedType == "json", ok)
+
+			switch tt.feedType {
+			case "rss":
+				assert.Equal(t, "t", rssFeed.Title)
+			case "atom":
+				assert.Equal(t, "t", atomFeed.Title)
+			case "json":
+				assert.Equal(t, "t", jsonFeed.Title)
+			}
+
+			feed, err = gofeed.NewParser().Parse(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			_, ok = feed.RSSFeed()
+			assert.False(t, ok)
+			_, ok = feed.AtomFeed()
+			assert.False(t, ok)
+			_, ok = feed.JSONFeed()
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestParser_Parse_itemIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		feed string
+	}{
+		{
+			name: "rss",
+			feed: `<rss version="2.0"><channel>
+<item><title>a</title></item>
+<item><title>b</title></item>
+<item><title>c</title></item>
+</channel></rss>`,
+		},
+		{
+			name: "atom",
+			feed: `<feed xmlns="http://www.w3.org/2005/Atom">
+<entry><title>a</title></entry>
+<entry><title>b</title></entry>
+<entry><title>c</title></entry>
+</feed>`,
+		},
+		{
+			name: "json",
+			feed: `{"version": "https://jsonfeed.org/version/1.1", "items": [
+{"id": "1", "title": "a"}, {"id": "2", "title": "b"}, {"id": "3", "title": "c"}
+]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser().Parse(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			require.Len(t, feed.Items, 3)
+			for i, title := range []string{"a", "b", "c"} {
+				assert.Equal(t, title, feed.Items[i].Title)
+				assert.Equal(t, i, feed.Items[i].Index)
+			}
+		})
+	}
+}
+
+func TestParser_Parse_stripControlEntities(t *testing.T) {
+	tests := []struct {
+		name string
+		feed string
+	}{
+		{
+			name: "rss",
+			feed: `<rss version="2.0"><channel><title>Title</title><item>
+<title><![CDATA[Item&#12; Title]]></title>
+<description><![CDATA[<p>Line&#x0C;&#x9;Tab&#10;</p>]]></description>
+</item></channel></rss>`,
+		},
+		{
+			name: "json",
+			feed: `{"version": "https://jsonfeed.org/version/1.1",
+"title": "Ti\u000ctle", "items": [{"id": "1", "title": "Item\u0007 Title",
+"summary": "<p>Line&#x0C;&#x9;Tab&#10;</p>"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser().Parse(strings.NewReader(tt.feed),
+				options.WithStripControlEntities(true))
+			require.NoError(t, err)
+			assert.Equal(t, "Title", feed.Title)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, "Item Title", feed.Items[0].Title)
+			assert.Equal(t, "<p>Line&#x9;Tab&#10;</p>", feed.Items[0].Description)
+
+			feed, err = gofeed.NewParser().Parse(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, "<p>Line&#x0C;&#x9;Tab&#10;</p>",
+				feed.Items[0].Description)
+		})
+	}
+}
+
+func TestParser_Parse_mergeContentDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		content     string
+		expected    string
+	}{
+		{
+			name:        "teaser",
+			description: "<p>Lead paragraph.</p>",
+			content:     "<p>The rest of the article.</p>",
+			expected:    "<p>Lead paragraph.</p>\n<p>The rest of the article.</p>",
+		},
+		{
+			name:        "prefix",
+			description: "<p>Lead paragraph.</p>",
+			content:     "<p>Lead paragraph.</p><p>The rest of the article.</p>",
+			expected:    "<p>Lead paragraph.</p><p>The rest of the article.</p>",
+		},
+		{
+			name:        "same",
+			description: "<p>The article.</p>",
+			content:     "<p>The article.</p>",
+			expected:    "<p>The article.</p>",
+		},
+		{
+			name:     "without description",
+			content:  "<p>The article.</p>",
+			expected: "<p>The article.</p>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser().Parse(strings.NewReader(`<rss version="2.0"
+xmlns:content="http://purl.org/rss/1.0/modules/content/"><channel><item>
+<description><![CDATA[`+tt.description+`]]></description>
+<content:encoded><![CDATA[`+tt.content+`]]></content:encoded>
+</item></channel></rss>`), options.WithMergeContentDescription(true))
+			require.NoError(t, err)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, tt.expected, feed.Items[0].Content)
+			assert.Equal(t, tt.description, feed.Items[0].Description)
+		})
+	}
+
+	feed, err := gofeed.NewParser().Parse(strings.NewReader(`<rss version="2.0"
+xmlns:content="http://purl.org/rss/1.0/modules/content/"><channel><item>
+<description>Lead</description><content:encoded>Rest</content:encoded>
+</item></channel></rss>`))
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "Rest", feed.Items[0].Content)
+}
+
+func TestParser_Reset(t *testing.T) {
+	b, err := os.ReadFile("testdata/parser/rss_stylesheet.xml")
+	require.NoError(t, err)
+
+	p := gofeed.NewParser(options.WithDetectedCharset(true))
+	feed, err := p.Parse(bytes.NewReader(b), options.WithKeepStylesheet(true),
+		options.WithKeepOriginalFeed(true))
+	require.NoError(t, err)
+	assert.NotEmpty(t, feed.Stylesheet)
+	assert.NotNil(t, feed.OriginalFeed)
+
+	// Options of previous Parse leak into the next one without Reset.
+	feed, err = p.Parse(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.NotEmpty(t, feed.Stylesheet)
+
+	p.Reset()
+	feed, err = p.Parse(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Empty(t, feed.Stylesheet)
+	assert.Nil(t, feed.OriginalFeed)
+	assert.Equal(t, "utf-8", feed.DetectedCharset,
+		"options of NewParser must survive Reset")
+}
+
 // An I/O error from the reader must surface as itself, not be masked as a
 // failed type detection (issue #311).
 func TestParser_Parse_ReaderError(t *testing.T) {
@@ -176,3 +400,493 @@ func TestParser_Parse_RootBeyondDetectionWindow(t *testing.T) {
 	require.NotNil(t, feed)
 	assert.Equal(t, "rss", feed.FeedType)
 }
+
+func TestParser_ParseWithType(t *testing.T) {
+	rssFeed, err := os.ReadFile("testdata/parser/rss_feed.xml")
+	require.NoError(t, err)
+	atomFeed, err := os.ReadFile("testdata/parser/atom10_feed.xml")
+	require.NoError(t, err)
+
+	p := gofeed.NewParser()
+	feed, err := p.ParseWithType(bytes.NewReader(rssFeed), gofeed.FeedTypeRSS)
+	require.NoError(t, err)
+	require.NotNil(t, feed)
+	assert.Equal(t, "rss", feed.FeedType)
+	assert.Equal(t, "Feed Title", feed.Title)
+
+	feed, err = p.ParseWithType(bytes.NewReader(atomFeed), gofeed.FeedTypeRSS)
+	require.Error(t, err)
+	assert.Nil(t, feed)
+
+	feed, err = p.ParseWithType(bytes.NewReader(rssFeed), gofeed.FeedTypeAtom)
+	require.Error(t, err)
+	assert.Nil(t, feed)
+
+	feed, err = p.ParseWithType(bytes.NewReader(rssFeed), gofeed.FeedTypeUnknown)
+	require.ErrorIs(t, err, gofeed.ErrFeedTypeNotDetected)
+	assert.Nil(t, feed)
+}
+
+func TestParser_Parse_streaming(t *testing.T) {
+	files := []string{
+		"atom03_feed.xml",
+		"atom10_feed.xml",
+		"rss_feed.xml",
+		"rss_feed_bom.xml",
+		"rss_feed_leading_spaces.xml",
+		"rdf_feed.xml",
+		"json11_feed.json",
+		"invalidutf8.xml",
+		"html_embedded_rss.html",
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			b, err := os.ReadFile(path.Join("testdata/parser/", file))
+			require.NoError(t, err)
+
+			expected, err := gofeed.NewParser(options.WithDetectEmbedded(true)).
+				Parse(bytes.NewReader(b))
+			require.NoError(t, err)
+
+			feed, err := gofeed.NewParser(options.WithDetectEmbedded(true),
+				options.WithStreaming(true)).
+				Parse(iotest.OneByteReader(bytes.NewReader(b)))
+			require.NoError(t, err)
+			assert.Equal(t, expected, feed)
+		})
+	}
+
+	for _, file := range []string{"unknown_feed.xml", "empty_feed.xml"} {
+		t.Run(file, func(t *testing.T) {
+			b, err := os.ReadFile(path.Join("testdata/parser/", file))
+			require.NoError(t, err)
+
+			feed, err := gofeed.NewParser(options.WithStreaming(true)).
+				Parse(bytes.NewReader(b))
+			require.ErrorIs(t, err, gofeed.ErrFeedTypeNotDetected)
+			assert.Nil(t, feed)
+		})
+	}
+}
+
+func TestParser_Parse_streamingSlowReader(t *testing.T) {
+	// The connection breaks after the first item. Streaming parser detects the
+	// feed and starts parsing it, before the reader fails.
+	errBroken := errors.New("connection broken")
+	newReader := func() io.Reader {
+		return io.MultiReader(strings.NewReader(`<rss version="2.0"><channel>
+<title>Feed Title</title>
+<item><title>Item 1</title></item>`), iotest.ErrReader(errBroken))
+	}
+
+	_, err := gofeed.NewParser().Parse(newReader())
+	require.ErrorIs(t, err, gofeed.ErrFeedTypeNotDetected)
+	require.ErrorIs(t, err, errBroken)
+
+	_, err = gofeed.NewParser(options.WithStreaming(true)).Parse(newReader())
+	require.ErrorIs(t, err, errBroken)
+	require.NotErrorIs(t, err, gofeed.ErrFeedTypeNotDetected)
+}
+
+func TestParser_Parse_decompressingReader(t *testing.T) {
+	b, err := os.ReadFile("testdata/parser/rss_feed.xml")
+	require.NoError(t, err)
+
+	expected, err := gofeed.NewParser().Parse(bytes.NewReader(b))
+	require.NoError(t, err)
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, err = zw.Write(b)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	var fl bytes.Buffer
+	fw, err := flate.NewWriter(&fl, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = fw.Write(b)
+	require.NoError(t, err)
+	require.NoError(t, fw.Close())
+
+	tests := []struct {
+		name      string
+		newReader func(t *testing.T) io.Reader
+	}{
+		{
+			name: "gzip",
+			newReader: func(t *testing.T) io.Reader {
+				r, err := gzip.NewReader(bytes.NewReader(gz.Bytes()))
+				require.NoError(t, err)
+				return r
+			},
+		},
+		{
+			name: "gzip one byte",
+			newReader: func(t *testing.T) io.Reader {
+				r, err := gzip.NewReader(
+					iotest.OneByteReader(bytes.NewReader(gz.Bytes())))
+				require.NoError(t, err)
+				return iotest.HalfReader(r)
+			},
+		},
+		{
+			name: "flate data with EOF",
+			newReader: func(t *testing.T) io.Reader {
+				return newDataEOFReader(
+					flate.NewReader(bytes.NewReader(fl.Bytes())))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser().Parse(tt.newReader(t))
+			require.NoError(t, err)
+			assert.Equal(t, expected, feed)
+
+			feed, err = gofeed.NewParser(options.WithStreaming(true)).
+				Parse(tt.newReader(t))
+			require.NoError(t, err)
+			assert.Equal(t, expected, feed)
+		})
+	}
+}
+
+// dataEOFReader returns io.EOF together with the last data, instead of
+// separate read.
+type dataEOFReader struct {
+	r *bufio.Reader
+}
+
+func newDataEOFReader(r io.Reader) *dataEOFReader {
+	return &dataEOFReader{r: bufio.NewReader(r)}
+}
+
+func (self *dataEOFReader) Read(p []byte) (int, error) {
+	n, err := self.r.Read(p)
+	if err == nil {
+		if _, err := self.r.Peek(1); errors.Is(err, io.EOF) {
+			return n, io.EOF
+		}
+	}
+	return n, err
+}
+
+// slowReader sleeps before every read.
+type slowReader struct {
+	r     io.Reader
+	delay time.Duration
+}
+
+func (self *slowReader) Read(p []byte) (int, error) {
+	time.Sleep(self.delay)
+	return self.r.Read(p)
+}
+
+func TestParser_Parse_withTimeout(t *testing.T) {
+	b, err := os.ReadFile("testdata/parser/rss_feed.xml")
+	require.NoError(t, err)
+
+	newReader := func() io.Reader {
+		return &slowReader{
+			r:     iotest.OneByteReader(bytes.NewReader(b)),
+			delay: time.Millisecond,
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts []options.Option
+	}{
+		{"buffered", nil},
+		{"streaming", []options.Option{options.WithStreaming(true)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout := options.WithTimeout(10 * time.Millisecond)
+			feed, err := gofeed.NewParser(tt.opts...).Parse(newReader(), timeout)
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Nil(t, feed)
+
+			feed, err = gofeed.NewParser(tt.opts...).
+				ParseWithType(newReader(), gofeed.FeedTypeRSS, timeout)
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Nil(t, feed)
+		})
+	}
+
+	feed, err := gofeed.NewParser(options.WithTimeout(time.Minute)).
+		Parse(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Equal(t, "Feed Title", feed.Title)
+}
+
+// blockedReader returns the first chunk of the feed and then blocks until it's
+// closed.
+type blockedReader struct {
+	r      io.Reader
+	closed chan struct{}
+}
+
+func (self *blockedReader) Read(p []byte) (int, error) {
+	if n, _ := self.r.Read(p); n > 0 {
+		return n, nil
+	}
+	<-self.closed
+	return 0, io.ErrClosedPipe
+}
+
+func TestParser_Parse_withTimeoutBlocked(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []options.Option
+	}{
+		{"buffered", nil},
+		{"streaming", []options.Option{options.WithStreaming(true)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &blockedReader{
+				r:      strings.NewReader(`<rss version="2.0"><channel>`),
+				closed: make(chan struct{}),
+			}
+			defer close(r.closed)
+
+			fp := gofeed.NewParser(tt.opts...)
+			feed, err := fp.Parse(r, options.WithTimeout(10*time.Millisecond))
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Nil(t, feed)
+		})
+	}
+}
+
+// Feeds in memory aren't read by goroutines, so parsers check the context by
+// themselves.
+func TestParser_Parse_withContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	tests := []struct {
+		name string
+		feed string
+	}{
+		{"rss", `<rss version="2.0"><channel><title>T</title></channel></rss>`},
+		{"atom", `<feed xmlns="http://www.w3.org/2005/Atom"><title>T</title></feed>`},
+		{"json", `{"version": "https://jsonfeed.org/version/1.1", "title": "T"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, streaming := range []bool{false, true} {
+				fp := gofeed.NewParser(options.WithContext(ctx),
+					options.WithStreaming(streaming))
+				feed, err := fp.Parse(strings.NewReader(tt.feed))
+				require.ErrorIs(t, err, context.Canceled)
+				assert.Nil(t, feed)
+			}
+
+			feed, err := gofeed.NewParser().Parse(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			assert.Equal(t, "T", feed.Title)
+		})
+	}
+}
+
+func TestParser_Parse_detectedCharset(t *testing.T) {
+	tests := []struct {
+		file    string
+		title   string
+		charset string
+	}{
+		{"rss_feed_latin1.xml", "Café", "iso-8859-1"},
+		{"rss_feed.xml", "Feed Title", "utf-8"},
+		{"atom10_feed.xml", "Feed Title", "utf-8"},
+		{"json11_feed.json", "title", "utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			b, err := os.ReadFile(path.Join("testdata/parser/", tt.file))
+			require.NoError(t, err)
+
+			feed, err := gofeed.NewParser(options.WithDetectedCharset(true)).
+				Parse(bytes.NewReader(b))
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, feed.Title)
+			assert.Equal(t, tt.charset, feed.DetectedCharset)
+
+			feed, err = gofeed.NewParser().Parse(bytes.NewReader(b))
+			require.NoError(t, err)
+			assert.Empty(t, feed.DetectedCharset)
+		})
+	}
+}
+
+func TestParser_Parse_latin1AsWindows1252(t *testing.T) {
+	// The feed declares ISO-8859-1, but contains Windows-1252 smart quotes,
+	// dashes and ellipsis in 0x80-0x9F range, which are control characters in
+	// latin1.
+	b, err := os.ReadFile("testdata/parser/rss_feed_latin1_cp1252.xml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		b    []byte
+		opts []options.Option
+	}{
+		{name: "buffered", b: b},
+		{
+			name: "streaming",
+			b:    b,
+			opts: []options.Option{options.WithStreaming(true)},
+		},
+		{
+			name: "charset fallback",
+			b: bytes.Replace(b, []byte("ISO-8859-1"), []byte("x-unknown-charset"),
+				1),
+			opts: []options.Option{options.WithCharsetFallback(true)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser(tt.opts...).Parse(bytes.NewReader(tt.b))
+			require.NoError(t, err)
+			assert.Equal(t, "It\u2019s \u201cquoted\u201d \u2014 café", feed.Title)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, "Don\u2019t panic\u2026", feed.Items[0].Title)
+		})
+	}
+}
+
+func TestParser_Parse_keepStylesheet(t *testing.T) {
+	b, err := os.ReadFile("testdata/parser/rss_stylesheet.xml")
+	require.NoError(t, err)
+
+	feed, err := gofeed.NewParser(options.WithKeepStylesheet(true)).
+		Parse(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Equal(t, "Stylesheet", feed.Title)
+	assert.Equal(t, "/feed.xsl?style=pretty&v=2", feed.Stylesheet)
+
+	feed, err = gofeed.NewParser().Parse(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Empty(t, feed.Stylesheet)
+}
+
+func TestParser_Parse_requireElements(t *testing.T) {
+	const rssFeed = `<rss version="2.0"><channel>
+<title>Feed Title</title>
+<description>Feed Description</description>
+<custom>value</custom>
+<customField>value</customField>
+</channel></rss>`
+
+	feed, err := gofeed.NewParser(options.WithRequireElements("link")).
+		Parse(strings.NewReader(rssFeed))
+	require.ErrorIs(t, err, gofeed.ErrMissingRequiredElement)
+	require.ErrorContains(t, err, `"link"`)
+	assert.Nil(t, feed)
+
+	feed, err = gofeed.NewParser(
+		options.WithRequireElements("Title", "description", "custom")).
+		Parse(strings.NewReader(rssFeed))
+	require.NoError(t, err)
+	assert.Equal(t, "Feed Title", feed.Title)
+
+	for _, name := range []string{"customField", "customfield", "CUSTOMFIELD"} {
+		_, err = gofeed.NewParser(options.WithRequireElements(name)).
+			Parse(strings.NewReader(rssFeed))
+		require.NoError(t, err, name)
+	}
+
+	const atomFeed = `<feed xmlns="http://www.w3.org/2005/Atom">
+<title>Feed Title</title>
+</feed>`
+
+	_, err = gofeed.NewParser(options.WithRequireElements("title", "id")).
+		Parse(strings.NewReader(atomFeed))
+	require.ErrorIs(t, err, gofeed.ErrMissingRequiredElement)
+	require.ErrorContains(t, err, `"id"`)
+
+	const jsonFeed = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "Feed Title"
+}`
+
+	_, err = gofeed.NewParser(options.WithRequireElements("items")).
+		Parse(strings.NewReader(jsonFeed))
+	require.ErrorIs(t, err, gofeed.ErrMissingRequiredElement)
+
+	_, err = gofeed.NewParser().Parse(strings.NewReader(rssFeed))
+	require.NoError(t, err)
+}
+
+func TestParser_ParseWithType_errorCategories(t *testing.T) {
+	tests := []struct {
+		name     string
+		feed     string
+		feedType gofeed.FeedType
+		is       []error
+		isNot    []error
+	}{
+		{
+			name:     "truncated rss",
+			feed:     `<rss version="2.0"><channel><title>Feed</title><item><title>a`,
+			feedType: gofeed.FeedTypeRSS,
+			is: []error{
+				gofeed.ErrInvalidXML, gofeed.ErrUnexpectedEndOfDocument,
+			},
+			isNot: []error{gofeed.ErrInvalidJSON, gofeed.ErrNoRootElement},
+		},
+		{
+			name:     "truncated atom",
+			feed:     `<feed xmlns="http://www.w3.org/2005/Atom"><entry><title>a`,
+			feedType: gofeed.FeedTypeAtom,
+			is: []error{
+				gofeed.ErrInvalidXML, gofeed.ErrUnexpectedEndOfDocument,
+			},
+		},
+		{
+			name:     "no root element",
+			feed:     `<?xml version="1.0"?>`,
+			feedType: gofeed.FeedTypeRSS,
+			is:       []error{gofeed.ErrNoRootElement},
+			isNot:    []error{gofeed.ErrInvalidXML},
+		},
+		{
+			name:     "invalid json",
+			feed:     `{"version": ]`,
+			feedType: gofeed.FeedTypeJSON,
+			is:       []error{gofeed.ErrInvalidJSON},
+			isNot: []error{
+				gofeed.ErrInvalidXML, gofeed.ErrUnexpectedEndOfDocument,
+			},
+		},
+		{
+			name:     "truncated json",
+			feed:     `{"version": `,
+			feedType: gofeed.FeedTypeJSON,
+			is: []error{
+				gofeed.ErrInvalidJSON, gofeed.ErrUnexpectedEndOfDocument,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gofeed.NewParser().ParseWithType(
+				strings.NewReader(tt.feed), tt.feedType)
+			require.Error(t, err)
+			for _, target := range tt.is {
+				require.ErrorIs(t, err, target)
+			}
+			for _, target := range tt.isNot {
+				require.NotErrorIs(t, err, target)
+			}
+		})
+	}
+}
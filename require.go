@@ -0,0 +1,132 @@
+package gofeed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dsh2dsh/gofeed/v2/atom"
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/json"
+	"github.com/dsh2dsh/gofeed/v2/rss"
+)
+
+// requireElements returns [ErrMissingRequiredElement] for the first element of
+// required, which is missing according to has.
+func requireElements(required []string, has func(name string) bool) error {
+	for _, name := range required {
+		if !has(strings.ToLower(name)) {
+			return fmt.Errorf("%w: %q", ErrMissingRequiredElement, name)
+		}
+	}
+	return nil
+}
+
+func rssHasElement(feed *rss.Feed, name string) bool {
+	switch name {
+	case "title":
+		return feed.Title != ""
+	case "link":
+		return len(feed.Links) != 0
+	case "description":
+		return feed.Description != ""
+	case "language":
+		return feed.Language != ""
+	case "copyright":
+		return feed.Copyright != ""
+	case "managingeditor":
+		return feed.ManagingEditor != ""
+	case "webmaster":
+		return feed.WebMaster != ""
+	case "pubdate":
+		return feed.PubDate != ""
+	case "lastbuilddate":
+		return feed.LastBuildDate != ""
+	case "category":
+		return len(feed.Categories) != 0
+	case "generator":
+		return feed.Generator != ""
+	case "docs":
+		return feed.Docs != ""
+	case "ttl":
+		return feed.TTL != ""
+	case "image":
+		return feed.Image != nil
+	case "item":
+		return len(feed.Items) != 0
+	}
+	return hasCustomElement(feed.Extensions, feed.UnknownElements, name)
+}
+
+func atomHasElement(feed *atom.Feed, name string) bool {
+	switch name {
+	case "title":
+		return feed.Title != ""
+	case "id":
+		return feed.ID != ""
+	case "updated":
+		return feed.Updated != ""
+	case "subtitle":
+		return feed.Subtitle != ""
+	case "link":
+		return len(feed.Links) != 0
+	case "generator":
+		return feed.Generator != nil
+	case "icon":
+		return feed.Icon != ""
+	case "logo":
+		return feed.Logo != ""
+	case "rights":
+		return feed.Rights != ""
+	case "author":
+		return len(feed.Authors) != 0
+	case "contributor":
+		return len(feed.Contributors) != 0
+	case "category":
+		return len(feed.Categories) != 0
+	case "entry":
+		return len(feed.Entries) != 0
+	}
+	return hasCustomElement(feed.Extensions, feed.UnknownElements, name)
+}
+
+func jsonHasElement(feed *json.Feed, name string) bool {
+	switch name {
+	case "title":
+		return feed.Title != ""
+	case "home_page_url":
+		return feed.HomePageURL != ""
+	case "feed_url":
+		return feed.FeedURL != ""
+	case "description":
+		return feed.Description != ""
+	case "icon":
+		return feed.Icon != ""
+	case "favicon":
+		return feed.Favicon != ""
+	case "author":
+		return feed.Author != nil || len(feed.Authors) != 0
+	case "language":
+		return feed.Language != ""
+	case "items":
+		return len(feed.Items) != 0
+	}
+	return false
+}
+
+func hasCustomElement(extensions ext.Extensions, unknown []ext.Extension,
+	name string,
+) bool {
+	// Extensions keep original case of element names.
+	for key, elements := range extensions["_custom"] {
+		if len(elements) != 0 && strings.EqualFold(key, name) {
+			return true
+		}
+	}
+
+	for i := range unknown {
+		if strings.EqualFold(unknown[i].Name, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package rss
 
 import (
 	"iter"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -41,7 +42,9 @@ type Feed struct {
 	DublinCoreExt       *ext.DublinCoreExtension `json:"dcExt,omitempty"`
 	ITunesExt           *ext.ITunesFeedExtension `json:"itunesExt,omitempty"`
 	Media               *ext.Media               `json:"media,omitempty"`
+	FOAF                *ext.FOAFExtension       `json:"foaf,omitempty"`
 	Extensions          ext.Extensions           `json:"extensions,omitempty"`
+	UnknownElements     []ext.Extension          `json:"unknownElements,omitempty"`
 	Items               []*Item                  `json:"items,omitempty"`
 	Version             string                   `json:"version,omitempty"`
 }
@@ -104,6 +107,8 @@ func (self *Feed) GetDescription() string {
 		return self.Description
 	case self.ITunesExt != nil && self.ITunesExt.Summary != "":
 		return self.ITunesExt.Summary
+	case self.AtomExt != nil:
+		return self.AtomExt.Subtitle
 	}
 	return ""
 }
@@ -153,8 +158,10 @@ func (self *Feed) GetUpdated() string {
 	switch {
 	case self.LastBuildDate != "":
 		return self.LastBuildDate
-	case self.DublinCoreExt != nil:
+	case self.DublinCoreExt != nil && self.DublinCoreExt.Date != "":
 		return self.DublinCoreExt.Date
+	case self.AtomExt != nil:
+		return self.AtomExt.Updated
 	}
 	return ""
 }
@@ -164,12 +171,14 @@ func (self *Feed) GetUpdatedParsed() *time.Time {
 		return self.LastBuildDateParsed
 	}
 
-	if self.DublinCoreExt == nil || self.DublinCoreExt.Date == "" {
-		return nil
+	if self.DublinCoreExt != nil && self.DublinCoreExt.Date != "" {
+		if date, err := date.Parse(self.DublinCoreExt.Date); err == nil {
+			return &date
+		}
 	}
 
-	if date, err := date.Parse(self.DublinCoreExt.Date); err == nil {
-		return &date
+	if self.AtomExt != nil {
+		return self.AtomExt.UpdatedParsed
 	}
 	return nil
 }
@@ -205,6 +214,12 @@ func (self *Feed) GetAuthor() (name, address string, ok bool) {
 			return owner.Name, owner.Email, true
 		}
 	}
+
+	if self.AtomExt != nil {
+		if person := self.AtomExt.GetAuthor(); person != nil {
+			return person.Name, person.Email, true
+		}
+	}
 	return name, address, false
 }
 
@@ -237,6 +252,11 @@ func (self *Feed) GetImage() *Image {
 			return &Image{URL: c.URL}
 		}
 	}
+
+	// The widest thumbnail.
+	if s := self.Media.BestThumbnail(math.MaxInt); s != "" {
+		return &Image{URL: s}
+	}
 	return nil
 }
 
@@ -250,6 +270,48 @@ func (self *Feed) GetCopyright() string {
 	return ""
 }
 
+// GeneratorName returns name part of the generator, like "WordPress" for
+// "WordPress 6.4".
+func (self *Feed) GeneratorName() string {
+	name, _ := splitGenerator(self.Generator)
+	return name
+}
+
+// GeneratorVersion returns version part of the generator, like "6.4" for
+// "WordPress 6.4" or "4.2.0" for "Jekyll v4.2.0". It returns empty string if
+// the generator has no trailing version.
+func (self *Feed) GeneratorVersion() string {
+	_, version := splitGenerator(self.Generator)
+	return version
+}
+
+func splitGenerator(s string) (name, version string) {
+	s = strings.TrimSpace(s)
+	i := strings.LastIndexByte(s, ' ')
+	if i < 0 {
+		return s, ""
+	}
+
+	version = strings.TrimPrefix(s[i+1:], "v")
+	if !looksLikeVersion(version) {
+		return s, ""
+	}
+	return strings.TrimSpace(s[:i]), version
+}
+
+func looksLikeVersion(s string) bool {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return false
+	}
+
+	for _, c := range s {
+		if (c < '0' || c > '9') && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
 func (self *Feed) AllCategories() iter.Seq[string] {
 	return self.categoriesIter
 }
@@ -309,26 +371,89 @@ func (self *Feed) GetTTL() int {
 	return ttl
 }
 
+// Syndication returns elements of the syndication module, like
+// <sy:updatePeriod>, from Extensions. It returns nil if the feed has no such
+// elements.
+func (self *Feed) Syndication() *ext.SyndicationExtension {
+	var sy *ext.SyndicationExtension
+	for m := range ext.ElementsSeq(self.Extensions, "sy") {
+		sy = new(ext.SyndicationExtension)
+		if e := m["updatePeriod"]; len(e) != 0 {
+			sy.UpdatePeriod = strings.TrimSpace(e[0].Value)
+		}
+
+		if e := m["updateFrequency"]; len(e) != 0 {
+			if n, err := strconv.Atoi(strings.TrimSpace(e[0].Value)); err == nil {
+				sy.UpdateFrequency = n
+			}
+		}
+
+		if e := m["updateBase"]; len(e) != 0 {
+			sy.UpdateBase = strings.TrimSpace(e[0].Value)
+			if t, err := date.Parse(sy.UpdateBase); err == nil {
+				t = t.UTC()
+				sy.UpdateBaseParsed = &t
+			}
+		}
+	}
+	return sy
+}
+
+// VersionMajorMinor returns major and minor parts of the feed version, like
+// 0 and 91 for "0.91". It returns zeros if the version is missing or can't be
+// parsed. Missing version of <rss> is detected by elements, which exist only in
+// RSS 0.9x, like lowercase <textinput> of 0.91 or <expirationDate> of 0.93.
+func (self *Feed) VersionMajorMinor() (major, minor int) {
+	s1, s2, _ := strings.Cut(self.Version, ".")
+	major, err := strconv.Atoi(s1)
+	if err != nil {
+		return 0, 0
+	}
+
+	minor, err = strconv.Atoi(s2)
+	if err != nil {
+		return major, 0
+	}
+	return major, minor
+}
+
 // Item is an RSS Item
 type Item struct {
-	Title         string                   `json:"title,omitempty"`
-	Links         []string                 `json:"links,omitempty"`
-	AtomLinks     []*atom.Link             `json:"atomLinks,omitempty"`
-	Description   string                   `json:"description,omitempty"`
-	Content       string                   `json:"content,omitempty"`
-	Author        string                   `json:"author,omitempty"`
-	Categories    []*Category              `json:"categories,omitempty"`
-	Comments      string                   `json:"comments,omitempty"`
-	Enclosure     *Enclosure               `json:"enclosure,omitempty"`
-	GUID          *GUID                    `json:"guid,omitempty"`
-	PubDate       string                   `json:"pubDate,omitempty"`
-	PubDateParsed *time.Time               `json:"pubDateParsed,omitempty"`
-	Source        *Source                  `json:"source,omitempty"`
-	AtomExt       *atom.Entry              `json:"atomExt,omitempty"`
-	DublinCoreExt *ext.DublinCoreExtension `json:"dcExt,omitempty"`
-	ITunesExt     *ext.ITunesItemExtension `json:"itunesExt,omitempty"`
-	Media         *ext.Media               `json:"media,omitempty"`
-	Extensions    ext.Extensions           `json:"extensions,omitempty"`
+	Title           string                      `json:"title,omitempty"`
+	Links           []string                    `json:"links,omitempty"`
+	AtomLinks       []*atom.Link                `json:"atomLinks,omitempty"`
+	Description     string                      `json:"description,omitempty"`
+	Content         string                      `json:"content,omitempty"`
+	Author          string                      `json:"author,omitempty"`
+	Categories      []*Category                 `json:"categories,omitempty"`
+	Comments        string                      `json:"comments,omitempty"`
+	Enclosure       *Enclosure                  `json:"enclosure,omitempty"`
+	GUID            *GUID                       `json:"guid,omitempty"`
+	PubDate         string                      `json:"pubDate,omitempty"`
+	PubDateParsed   *time.Time                  `json:"pubDateParsed,omitempty"`
+	Source          *Source                     `json:"source,omitempty"`
+	AtomExt         *atom.Entry                 `json:"atomExt,omitempty"`
+	DublinCoreExt   *ext.DublinCoreExtension    `json:"dcExt,omitempty"`
+	ITunesExt       *ext.ITunesItemExtension    `json:"itunesExt,omitempty"`
+	Media           *ext.Media                  `json:"media,omitempty"`
+	Company         *ext.CompanyExtension       `json:"company,omitempty"`
+	Taxonomy        *ext.TaxonomyExtension      `json:"taxonomy,omitempty"`
+	Pingback        *ext.PingbackExtension      `json:"pingback,omitempty"`
+	Reference       *ext.ReferenceExtension     `json:"reference,omitempty"`
+	Streaming       *ext.StreamingExtension     `json:"streaming,omitempty"`
+	Search          *ext.SearchExtension        `json:"search,omitempty"`
+	FOAF            *ext.FOAFExtension          `json:"foaf,omitempty"`
+	Email           *ext.EmailExtension         `json:"email,omitempty"`
+	ServiceStatus   *ext.ServiceStatusExtension `json:"serviceStatus,omitempty"`
+	GeoRSS          *ext.GeoExtension           `json:"geoRSS,omitempty"`
+	Podcast         *ext.PodcastItemExtension   `json:"podcast,omitempty"`
+	Extensions      ext.Extensions              `json:"extensions,omitempty"`
+	UnknownElements []ext.Extension             `json:"unknownElements,omitempty"`
+
+	// RawBodyOrder is order of title, description and content elements of the
+	// item in the feed, like ["title", "content", "description"]. It allows to
+	// render them in the order, intended by the publisher.
+	RawBodyOrder []string `json:"rawBodyOrder,omitempty"`
 }
 
 // Enclosure is a media object that is attached to
@@ -404,7 +529,7 @@ func (self *Item) GetUpdated() string {
 	case self.AtomExt != nil && self.AtomExt.Updated != "":
 		return self.AtomExt.Updated
 	}
-	return ""
+	return self.dctermsModified()
 }
 
 func (self *Item) GetUpdatedParsed() *time.Time {
@@ -415,12 +540,29 @@ func (self *Item) GetUpdatedParsed() *time.Time {
 		}
 	}
 
-	if self.AtomExt != nil {
+	if self.AtomExt != nil && self.AtomExt.UpdatedParsed != nil {
 		return self.AtomExt.UpdatedParsed
 	}
+
+	if s := self.dctermsModified(); s != "" {
+		if modified, err := date.Parse(s); err == nil {
+			modified = modified.UTC()
+			return &modified
+		}
+	}
 	return nil
 }
 
+// dctermsModified returns value of <dcterms:modified> element.
+func (self *Item) dctermsModified() string {
+	for m := range ext.ElementsSeq(self.Extensions, "dcterms") {
+		if e := m["modified"]; len(e) != 0 {
+			return e[0].Value
+		}
+	}
+	return ""
+}
+
 func (self *Item) GetPublished() string {
 	switch {
 	case self.PubDate != "":
@@ -481,6 +623,23 @@ func (self *Item) GetAuthor() (name, address string, ok bool) {
 	return name, address, false
 }
 
+// GetLanguage returns language of the item from <dc:language>.
+func (self *Item) GetLanguage() string {
+	if self.DublinCoreExt != nil {
+		return self.DublinCoreExt.Language
+	}
+	return ""
+}
+
+// CompanyTickers returns ticker symbols of companies from the company module,
+// like <co:ticker>.
+func (self *Item) CompanyTickers() []string {
+	if self.Company == nil {
+		return nil
+	}
+	return self.Company.Tickers
+}
+
 func (self *Item) GetGUID() string {
 	if self.GUID != nil {
 		return self.GUID.Value
@@ -510,6 +669,83 @@ func (self *Item) ImageURL() string {
 	return ""
 }
 
+// PingbackServer returns URL of the pingback server of the item, from the
+// pingback module.
+func (self *Item) PingbackServer() string {
+	if self.Pingback == nil {
+		return ""
+	}
+	return self.Pingback.Server
+}
+
+// IsLive returns true if the item is marked as live content by the streaming
+// module.
+func (self *Item) IsLive() bool {
+	return self.Streaming != nil && self.Streaming.IsLive()
+}
+
+// SearchScore returns relevance score of the item from the search module. It
+// returns false if the item has no score.
+func (self *Item) SearchScore() (float64, bool) {
+	if self.Search == nil {
+		return 0, false
+	}
+	return self.Search.ScoreValue()
+}
+
+// RelatedLinks returns resources, referenced by the item using the reference
+// module, followed by atom links with rel="related". Duplicates are removed.
+func (self *Item) RelatedLinks() []string {
+	var links []string
+	seen := make(map[string]struct{})
+	appendLink := func(link string) {
+		if _, ok := seen[link]; !ok {
+			seen[link] = struct{}{}
+			links = append(links, link)
+		}
+	}
+
+	if self.Reference != nil {
+		for _, ref := range self.Reference.References {
+			appendLink(ref.Resource)
+		}
+	}
+
+	if self.AtomExt != nil {
+		for _, link := range self.AtomExt.RelatedLinks() {
+			appendLink(link)
+		}
+	}
+	return links
+}
+
+// CategorySet returns categories of the item, merged with topics of the
+// taxonomy module. Topics have [ext.TaxonomyNamespace] as domain. Duplicates
+// are dropped.
+func (self *Item) CategorySet() []*Category {
+	if self.Taxonomy == nil || len(self.Taxonomy.Topics) == 0 {
+		return self.Categories
+	}
+
+	categories := make([]*Category, 0,
+		len(self.Categories)+len(self.Taxonomy.Topics))
+	seen := make(map[Category]struct{}, cap(categories))
+	appendCategory := func(c *Category) {
+		if _, ok := seen[*c]; !ok {
+			seen[*c] = struct{}{}
+			categories = append(categories, c)
+		}
+	}
+
+	for _, c := range self.Categories {
+		appendCategory(c)
+	}
+	for _, topic := range self.Taxonomy.Topics {
+		appendCategory(&Category{Domain: ext.TaxonomyNamespace, Value: topic})
+	}
+	return categories
+}
+
 func (self *Item) AllCategories() iter.Seq[string] {
 	return self.categoriesIter
 }
@@ -626,25 +862,7 @@ func (self *Item) mediaThumbnails() iter.Seq[Enclosure] {
 func (self *Item) mediaContents() iter.Seq[Enclosure] {
 	return func(yield func(Enclosure) bool) {
 		for content := range self.Media.AllContents() {
-			enc := Enclosure{
-				URL:    content.URL,
-				Length: content.FileSize,
-				Type:   content.Type,
-			}
-
-			if enc.Type == "" {
-				switch content.Medium {
-				case "image":
-					enc.Type = "image/*"
-				case "video":
-					enc.Type = "video/*"
-				case "audio":
-					enc.Type = "audio/*"
-				default:
-					enc.Type = "application/octet-stream"
-				}
-			}
-
+			enc := contentEnclosure(&content)
 			if enc.URL != "" && !yield(enc) {
 				return
 			}
@@ -652,6 +870,43 @@ func (self *Item) mediaContents() iter.Seq[Enclosure] {
 	}
 }
 
+// PrimaryEnclosure returns the default media content of the item, or its first
+// media content, as a single representative enclosure. Unlike
+// [Item.AllEnclosures], it ignores other encodings of the same media. It
+// returns nil if the item has no media contents.
+func (self *Item) PrimaryEnclosure() *Enclosure {
+	if self.Media == nil {
+		return nil
+	}
+
+	if c := self.Media.DefaultContent(); c != nil {
+		enc := contentEnclosure(c)
+		return &enc
+	}
+	return nil
+}
+
+func contentEnclosure(content *ext.MediaContent) Enclosure {
+	enc := Enclosure{URL: content.URL, Type: content.Type}
+	if n := content.FileSizeBytes(); n > 0 {
+		enc.Length = strconv.FormatInt(n, 10)
+	}
+
+	if enc.Type == "" {
+		switch content.Medium {
+		case "image":
+			enc.Type = "image/*"
+		case "video":
+			enc.Type = "video/*"
+		case "audio":
+			enc.Type = "audio/*"
+		default:
+			enc.Type = "application/octet-stream"
+		}
+	}
+	return enc
+}
+
 func (self *Item) mediaPeerLinks() iter.Seq[Enclosure] {
 	return func(yield func(Enclosure) bool) {
 		for pl := range self.Media.AllPeerLinks() {
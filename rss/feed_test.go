@@ -0,0 +1,176 @@
+package rss_test
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/rss"
+)
+
+func TestFeed_Generator(t *testing.T) {
+	tests := []struct {
+		generator string
+		name      string
+		version   string
+	}{
+		{"WordPress 6.4", "WordPress", "6.4"},
+		{"Jekyll v4.2.0", "Jekyll", "4.2.0"},
+		{"Feed Generator", "Feed Generator", ""},
+		{"Hugo", "Hugo", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.generator, func(t *testing.T) {
+			feed := rss.Feed{Generator: tt.generator}
+			assert.Equal(t, tt.name, feed.GeneratorName())
+			assert.Equal(t, tt.version, feed.GeneratorVersion())
+		})
+	}
+}
+
+func TestItem_CompanyTickers(t *testing.T) {
+	f, err := os.Open("testdata/rss_channel_item_company.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, []string{"EXMP"}, feed.Items[0].CompanyTickers())
+	assert.Nil(t, (&rss.Item{}).CompanyTickers())
+}
+
+func TestItem_CategorySet(t *testing.T) {
+	f, err := os.Open("testdata/rss_channel_item_taxonomy.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+
+	item := feed.Items[0]
+	item.Categories = append(item.Categories, &rss.Category{
+		Domain: ext.TaxonomyNamespace,
+		Value:  "http://example.org/topics/space",
+	})
+
+	assert.Equal(t, []*rss.Category{
+		{Domain: "http://example.org/tags", Value: "news"},
+		{Domain: ext.TaxonomyNamespace, Value: "http://example.org/topics/space"},
+		{Domain: ext.TaxonomyNamespace, Value: "http://example.org/topics/science"},
+		{Domain: ext.TaxonomyNamespace, Value: "http://example.org/topics/physics"},
+	}, item.CategorySet())
+	assert.Nil(t, (&rss.Item{}).CategorySet())
+}
+
+func TestItem_PingbackServer(t *testing.T) {
+	f, err := os.Open("testdata/rss_channel_item_pingback.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "http://example.org/xmlrpc.php",
+		feed.Items[0].PingbackServer())
+	assert.Empty(t, (&rss.Item{}).PingbackServer())
+}
+
+func TestItem_IsLive(t *testing.T) {
+	f, err := os.Open("testdata/rss_channel_item_streaming.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.True(t, feed.Items[0].IsLive())
+	assert.False(t, (&rss.Item{}).IsLive())
+
+	item := rss.Item{Streaming: &ext.StreamingExtension{Live: "no"}}
+	assert.False(t, item.IsLive())
+	item.Streaming.Type = "LIVE"
+	assert.True(t, item.IsLive())
+}
+
+func TestItem_SearchScore(t *testing.T) {
+	f, err := os.Open("testdata/rss_channel_item_search.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 3)
+
+	score, ok := feed.Items[0].SearchScore()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.95, score, 1e-9)
+
+	score, ok = feed.Items[1].SearchScore()
+	assert.True(t, ok)
+	assert.InDelta(t, 72.0, score, 1e-9)
+
+	_, ok = feed.Items[2].SearchScore()
+	assert.False(t, ok)
+
+	item := rss.Item{Search: &ext.SearchExtension{Score: "high"}}
+	_, ok = item.SearchScore()
+	assert.False(t, ok)
+}
+
+func TestItem_PrimaryEnclosure(t *testing.T) {
+	f, err := os.Open("testdata/rss_channel_item_media_group_default.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 3)
+
+	assert.Equal(t, &rss.Enclosure{
+		URL:  "http://example.org/video-720.mp4",
+		Type: "video/mp4",
+	}, feed.Items[0].PrimaryEnclosure())
+
+	assert.Equal(t, &rss.Enclosure{
+		URL:  "http://example.org/audio.ogg",
+		Type: "audio/*",
+	}, feed.Items[1].PrimaryEnclosure())
+
+	assert.Nil(t, feed.Items[2].PrimaryEnclosure())
+}
+
+func TestItem_AllEnclosures_fileSize(t *testing.T) {
+	f, err := os.Open("testdata/rss_channel_item_media_content_file_size.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+
+	require.NotNil(t, item.Media)
+	require.Len(t, item.Media.Contents, 2)
+	assert.Equal(t, int64(10485760), item.Media.Contents[0].FileSizeBytes())
+	assert.Zero(t, item.Media.Contents[1].FileSizeBytes())
+
+	assert.Equal(t, []rss.Enclosure{
+		{
+			URL:    "http://example.org/video.mp4",
+			Length: "10485760",
+			Type:   "video/mp4",
+		},
+		{
+			URL:  "http://example.org/video.webm",
+			Type: "video/webm",
+		},
+	}, slices.Collect(item.AllEnclosures()))
+}
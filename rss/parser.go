@@ -5,22 +5,58 @@ import (
 	"io"
 	"iter"
 	"maps"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dsh2dsh/gofeed/v2/atom"
 	"github.com/dsh2dsh/gofeed/v2/ext"
+	"github.com/dsh2dsh/gofeed/v2/internal/company"
 	"github.com/dsh2dsh/gofeed/v2/internal/date"
 	"github.com/dsh2dsh/gofeed/v2/internal/dublincore"
+	"github.com/dsh2dsh/gofeed/v2/internal/email"
+	"github.com/dsh2dsh/gofeed/v2/internal/foaf"
+	"github.com/dsh2dsh/gofeed/v2/internal/georss"
 	"github.com/dsh2dsh/gofeed/v2/internal/itunes"
 	"github.com/dsh2dsh/gofeed/v2/internal/media"
+	"github.com/dsh2dsh/gofeed/v2/internal/pingback"
+	"github.com/dsh2dsh/gofeed/v2/internal/podcast"
+	"github.com/dsh2dsh/gofeed/v2/internal/reference"
+	"github.com/dsh2dsh/gofeed/v2/internal/search"
+	"github.com/dsh2dsh/gofeed/v2/internal/servicestatus"
 	"github.com/dsh2dsh/gofeed/v2/internal/shared"
+	"github.com/dsh2dsh/gofeed/v2/internal/streaming"
+	"github.com/dsh2dsh/gofeed/v2/internal/taxonomy"
 	"github.com/dsh2dsh/gofeed/v2/internal/xml"
 	"github.com/dsh2dsh/gofeed/v2/options"
 )
 
 var emptyAttrs = map[string]string{}
 
+// itemElements maps prefixes of extensions, which are parsed into typed fields
+// of items, to functions, which return true if the extension parser knows
+// lowercased name of the element. Unknown elements are kept in Extensions.
+var itemElements = map[string]func(name string) bool{
+	"georss":   georss.IsItemElement,
+	"search":   search.IsItemElement,
+	"str":      streaming.IsItemElement,
+	"ref":      reference.IsItemElement,
+	"email":    email.IsItemElement,
+	"ss":       servicestatus.IsItemElement,
+	"co":       company.IsItemElement,
+	"taxo":     taxonomy.IsItemElement,
+	"pingback": pingback.IsItemElement,
+	"foaf":     foaf.IsPersonElement,
+	"podcast":  podcast.IsItemElement,
+}
+
+// channelElements is like [itemElements], but for channels.
+var channelElements = map[string]func(name string) bool{
+	"foaf": foaf.IsPersonElement,
+}
+
 // Parser is a RSS Parser
 type Parser struct {
 	p    *xml.Parser
@@ -29,6 +65,10 @@ type Parser struct {
 
 	opts options.Parse
 	atom *atom.ExtensionParser
+
+	// version09x is the RSS 0.9x version, detected by elements, which exist
+	// only in that version. See [Parser.refineVersion].
+	version09x string
 }
 
 // NewParser creates a new RSS parser
@@ -41,16 +81,88 @@ func (self *Parser) Parse(r io.Reader, opts ...options.Option) (*Feed, error) {
 	self.atom = atom.NewExtension(self.p, options.From(self.opts))
 
 	if _, err := self.p.FindRoot(); err != nil {
-		return nil, fmt.Errorf("gofeed/rss: %w", err)
+		return nil, xml.CategorizeErr(fmt.Errorf("gofeed/rss: %w", err))
 	}
 
 	self.root(self.p.Name)
 	if err := self.Err(); err != nil {
-		return nil, err
+		return nil, xml.CategorizeErr(err)
+	}
+
+	if self.opts.Validate {
+		self.validate()
 	}
 	return self.feed, nil
 }
 
+// validate records warnings about problems of parsed feed, which don't prevent
+// its parsing.
+func (self *Parser) validate() {
+	self.validateImage()
+	self.validateNewFeedURL()
+}
+
+func (self *Parser) validateImage() {
+	img := self.feed.Image
+	if img == nil || img.Link == "" {
+		return
+	}
+
+	link := self.feed.Link()
+	if link != "" && !sameLink(img.Link, link) {
+		self.p.Warn(fmt.Errorf(
+			"gofeed/rss: image link %q doesn't match channel link %q",
+			img.Link, link))
+	}
+}
+
+func (self *Parser) validateNewFeedURL() {
+	itunes := self.feed.ITunesExt
+	if itunes == nil || itunes.NewFeedURL == "" {
+		return
+	}
+
+	u, err := url.Parse(itunes.NewFeedURL)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		self.p.Warn(fmt.Errorf(
+			"gofeed/rss: itunes:new-feed-url %q isn't an absolute URL",
+			itunes.NewFeedURL))
+	}
+}
+
+func sameLink(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+// Warnings returns problems, which the parser worked around during last
+// parse, like unsupported charset with [options.WithCharsetFallback], or
+// problems found by [options.WithValidate].
+func (self *Parser) Warnings() []error {
+	if self.p == nil {
+		return nil
+	}
+	return self.p.Warnings()
+}
+
+// Charset returns charset of last parsed feed, declared by its XML prolog. It
+// returns "utf-8" if the feed doesn't declare another charset.
+func (self *Parser) Charset() string {
+	if self.p == nil {
+		return ""
+	}
+	return self.p.Charset()
+}
+
+// Stylesheet returns href of xml-stylesheet processing instruction of last
+// parsed feed, if it was parsed with [options.WithKeepStylesheet].
+func (self *Parser) Stylesheet() string {
+	if self.p == nil {
+		return ""
+	}
+	return self.p.Stylesheet()
+}
+
 func (self *Parser) Err() error {
 	switch {
 	case self.err != nil:
@@ -62,12 +174,20 @@ func (self *Parser) Err() error {
 }
 
 func (self *Parser) root(name string) {
+	switch strings.ToLower(name) {
+	case "rss", "rdf":
+	default:
+		self.err = fmt.Errorf("gofeed/rss: unexpected root element %q", name)
+		return
+	}
+
 	children := self.makeChildrenSeq(name)
 	if children == nil {
 		return
 	}
 
 	self.feed = &Feed{Version: self.version(name)}
+	self.version09x = ""
 
 	for name := range children {
 		// Skip any extensions found in the feed root.
@@ -90,10 +210,14 @@ func (self *Parser) root(name string) {
 		}
 	}
 
-	if self.err != nil || self.feed.AtomExt == nil {
+	if self.err != nil {
 		return
 	}
-	self.feed.AtomLinks = self.feed.AtomExt.Links
+	self.refineVersion(name)
+
+	if self.feed.AtomExt != nil {
+		self.feed.AtomLinks = self.feed.AtomExt.Links
+	}
 }
 
 func (self *Parser) makeChildrenSeq(name string) iter.Seq[string] {
@@ -179,6 +303,10 @@ func (self *Parser) channelBody(name string) {
 	case "image":
 		rss.Image = self.image(name)
 	case "textinput":
+		// RSS 0.91 spells it in lowercase, and later versions as textInput.
+		if self.p.Name == "textinput" {
+			self.detectVersion09x("0.91")
+		}
 		rss.TextInput = self.textInput(name)
 	case "items":
 		// Skip RDF items element - it's a structural element
@@ -187,7 +315,9 @@ func (self *Parser) channelBody(name string) {
 	default:
 		// For non-standard RSS channel elements, add them to extensions
 		// under a special "_custom" namespace prefix
-		if e, ok := self.parseCustomExtInto(name, rss.Extensions); ok {
+		if self.opts.UnknownElementsSeparate {
+			rss.UnknownElements = self.appendUnknown(name, rss.UnknownElements)
+		} else if e, ok := self.parseCustomExtInto(name, rss.Extensions); ok {
 			rss.Extensions = e
 		}
 	}
@@ -200,8 +330,13 @@ func (self *Parser) appendItem(name string, items []*Item) []*Item {
 	}
 
 	item := new(Item)
-	for name := range children {
-		self.itemBody(name, item)
+	for child := range children {
+		self.itemBody(child, item)
+		if self.err == nil && dateElements[child] && !self.itemSince(item) {
+			// Don't waste time on the rest of the old item.
+			self.err = self.p.SkipRest(name)
+			return items
+		}
 	}
 
 	if self.err != nil {
@@ -214,6 +349,39 @@ func (self *Parser) appendItem(name string, items []*Item) []*Item {
 	return append(items, item)
 }
 
+// dateElements are names of item elements, including extensions, which can
+// change result of itemSince.
+var dateElements = map[string]bool{
+	"pubdate":   true,
+	"date":      true,
+	"updated":   true,
+	"published": true,
+	"modified":  true,
+	"issued":    true,
+}
+
+func (self *Parser) itemSince(item *Item) bool {
+	since := self.opts.ItemsSince
+	if since.IsZero() {
+		return true
+	}
+
+	updated, published := item.GetUpdatedParsed(), item.GetPublishedParsed()
+	if updated == nil && published == nil {
+		return true
+	}
+	return (updated != nil && updated.After(since)) ||
+		(published != nil && published.After(since))
+}
+
+// appendBodyOrder appends name to order, if it isn't there yet.
+func appendBodyOrder(order []string, name string) []string {
+	if slices.Contains(order, name) {
+		return order
+	}
+	return append(order, name)
+}
+
 func (self *Parser) itemBody(name string, item *Item) {
 	if self.parseItemExt(name, item) {
 		return
@@ -224,11 +392,14 @@ func (self *Parser) itemBody(name string, item *Item) {
 	switch name {
 	case "title":
 		item.Title = self.p.Text()
+		item.RawBodyOrder = appendBodyOrder(item.RawBodyOrder, name)
 	case "description":
 		item.Description = self.p.Text()
+		item.RawBodyOrder = appendBodyOrder(item.RawBodyOrder, name)
 	case "encoded":
-		if self.p.NamespacePrefix() == "content" {
+		if self.p.NamespacePrefix() == "content" || self.lenientEncoded(name) {
 			item.Content = self.p.Text()
+			item.RawBodyOrder = appendBodyOrder(item.RawBodyOrder, "content")
 		} else {
 			intoCustom = true
 		}
@@ -248,6 +419,11 @@ func (self *Parser) itemBody(name string, item *Item) {
 		item.GUID = self.guid(name)
 	case "category":
 		item.Categories = self.appendCategory(name, item.Categories)
+	case "expirationdate":
+		// RSS 0.93 and 0.94 only, it was dropped by RSS 2.0. Keep it as before,
+		// as a non-standard element.
+		self.detectVersion09x("0.93")
+		intoCustom = true
 	default:
 		// For non-standard RSS elements, add them to extensions
 		// under a special "_custom" namespace prefix
@@ -258,7 +434,9 @@ func (self *Parser) itemBody(name string, item *Item) {
 		return
 	}
 
-	if e, ok := self.parseCustomExtInto(name, item.Extensions); ok {
+	if self.opts.UnknownElementsSeparate {
+		item.UnknownElements = self.appendUnknown(name, item.UnknownElements)
+	} else if e, ok := self.parseCustomExtInto(name, item.Extensions); ok {
 		item.Extensions = e
 	}
 }
@@ -279,6 +457,9 @@ func (self *Parser) appendLink(name string, links []string) []string {
 	if err != nil {
 		self.err = err
 		return links
+	} else if url = strings.TrimSpace(url); url == "" {
+		// Empty <link/> or <link></link> has no link.
+		return links
 	}
 
 	u, err := self.p.XmlBaseResolveUrl(url)
@@ -523,7 +704,7 @@ func (self *Parser) makeCloud() *Cloud {
 func (self *Parser) version(name string) string {
 	switch strings.ToLower(name) {
 	case "rss":
-		return self.p.Attribute("version")
+		return normalizeVersion(self.p.Attribute("version"))
 	case "rdf":
 		switch self.p.Attribute("xmlns") {
 		case "http://channel.netscape.com/rdf/simple/0.9/",
@@ -536,26 +717,44 @@ func (self *Parser) version(name string) string {
 	return ""
 }
 
+// detectVersion09x records RSS 0.9x version v, detected by an element, which
+// exists only in this version, keeping the highest detected version.
+func (self *Parser) detectVersion09x(v string) {
+	self.version09x = max(self.version09x, v)
+}
+
+// refineVersion sets version of the feed with root element name to detected
+// RSS 0.9x version, if the version attribute is missing. Declared version wins
+// over detected one.
+func (self *Parser) refineVersion(name string) {
+	if self.feed.Version == "" && strings.EqualFold(name, "rss") {
+		self.feed.Version = self.version09x
+	}
+}
+
+// normalizeVersion trims given version and adds missing minor part, so "2"
+// becomes "2.0".
+func normalizeVersion(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.Contains(s, ".") {
+		return s
+	}
+
+	if _, err := strconv.Atoi(s); err != nil {
+		return s
+	}
+	return s + ".0"
+}
+
 func (self *Parser) parseCustomExtInto(name string, extensions ext.Extensions,
 ) (ext.Extensions, bool) {
-	if self.opts.SkipUnknownElements {
+	if self.opts.SkipCustomExtensions {
 		self.p.Skip(name)
 		return extensions, false
 	}
 
-	custom := ext.Extension{Name: self.p.Name, Attrs: emptyAttrs}
-	// Copy attributes
-	if n := len(self.p.Attrs); n != 0 {
-		custom.Attrs = make(map[string]string, n)
-		maps.Insert(custom.Attrs, self.p.AttributeSeq())
-	}
-
-	err := self.p.WithText(name, nil, func(s string) error {
-		custom.Value = s
-		return nil
-	})
-	if err != nil {
-		self.err = err
+	custom, ok := self.customElement(name)
+	if !ok {
 		return extensions, false
 	}
 
@@ -572,7 +771,13 @@ func (self *Parser) parseCustomExtInto(name string, extensions ext.Extensions,
 }
 
 func (self *Parser) parseChannelExt(name string, rss *Feed) bool {
-	switch ns := self.p.ExtensionPrefix(); ns {
+	prefix := self.p.ExtensionPrefix()
+	if known, ok := channelElements[prefix]; ok && !known(name) {
+		rss.Extensions = self.extensions(name, rss.Extensions)
+		return true
+	}
+
+	switch prefix {
 	case "":
 		return false
 	case "dc":
@@ -581,6 +786,8 @@ func (self *Parser) parseChannelExt(name string, rss *Feed) bool {
 		rss.ITunesExt = self.itunesFeed(rss.ITunesExt)
 	case "media":
 		rss.Media = self.media(rss.Media)
+	case "foaf":
+		rss.FOAF = self.foaf(rss.FOAF)
 	case "atom", "atom10", "atom03":
 		rss.AtomExt = self.atomFeed(rss.AtomExt)
 	default:
@@ -606,6 +813,100 @@ func (self *Parser) dublinCore(dc *ext.DublinCoreExtension,
 	return dc
 }
 
+func (self *Parser) company(co *ext.CompanyExtension) *ext.CompanyExtension {
+	co, err := company.Parse(self.p, co)
+	if err != nil {
+		self.err = err
+	}
+	return co
+}
+
+func (self *Parser) taxonomy(taxo *ext.TaxonomyExtension,
+) *ext.TaxonomyExtension {
+	taxo, err := taxonomy.Parse(self.p, taxo)
+	if err != nil {
+		self.err = err
+	}
+	return taxo
+}
+
+func (self *Parser) pingback(pb *ext.PingbackExtension,
+) *ext.PingbackExtension {
+	pb, err := pingback.Parse(self.p, pb)
+	if err != nil {
+		self.err = err
+	}
+	return pb
+}
+
+func (self *Parser) reference(ref *ext.ReferenceExtension,
+) *ext.ReferenceExtension {
+	ref, err := reference.Parse(self.p, ref)
+	if err != nil {
+		self.err = err
+	}
+	return ref
+}
+
+func (self *Parser) foaf(fe *ext.FOAFExtension) *ext.FOAFExtension {
+	fe, err := foaf.Parse(self.p, fe)
+	if err != nil {
+		self.err = err
+	}
+	return fe
+}
+
+func (self *Parser) email(e *ext.EmailExtension) *ext.EmailExtension {
+	e, err := email.Parse(self.p, e)
+	if err != nil {
+		self.err = err
+	}
+	return e
+}
+
+func (self *Parser) serviceStatus(ss *ext.ServiceStatusExtension,
+) *ext.ServiceStatusExtension {
+	ss, err := servicestatus.Parse(self.p, ss)
+	if err != nil {
+		self.err = err
+	}
+	return ss
+}
+
+func (self *Parser) geoRSS(geo *ext.GeoExtension) *ext.GeoExtension {
+	geo, err := georss.Parse(self.p, geo)
+	if err != nil {
+		self.err = err
+	}
+	return geo
+}
+
+func (self *Parser) podcastItem(pi *ext.PodcastItemExtension,
+) *ext.PodcastItemExtension {
+	pi, err := podcast.ParseItem(self.p, pi)
+	if err != nil {
+		self.err = err
+	}
+	return pi
+}
+
+func (self *Parser) streaming(str *ext.StreamingExtension,
+) *ext.StreamingExtension {
+	str, err := streaming.Parse(self.p, str)
+	if err != nil {
+		self.err = err
+	}
+	return str
+}
+
+func (self *Parser) search(s *ext.SearchExtension) *ext.SearchExtension {
+	s, err := search.Parse(self.p, s)
+	if err != nil {
+		self.err = err
+	}
+	return s
+}
+
 func (self *Parser) itunesFeed(feed *ext.ITunesFeedExtension,
 ) *ext.ITunesFeedExtension {
 	feed, err := itunes.ParseFeed(self.p, feed)
@@ -621,15 +922,32 @@ func (self *Parser) extensions(name string, e ext.Extensions) ext.Extensions {
 		return e
 	}
 
-	e, err := shared.ParseExtension(e, self.p.XMLPullParser)
+	e, err := shared.ParseExtension(e, self.p.XMLPullParser,
+		self.p.NamespacePrefix())
 	if err != nil {
 		self.err = err
 	}
 	return e
 }
 
+// lenientEncoded returns true if current element looks like content:encoded,
+// which namespace wasn't declared by the feed, and lenient mode is enabled.
+func (self *Parser) lenientEncoded(name string) bool {
+	return self.opts.Lenient && name == "encoded" && self.p.UndeclaredNamespace()
+}
+
 func (self *Parser) parseItemExt(name string, item *Item) bool {
-	switch self.p.ExtensionPrefix() {
+	if self.lenientEncoded(name) {
+		return false
+	}
+
+	prefix := self.p.ExtensionPrefix()
+	if known, ok := itemElements[prefix]; ok && !known(name) {
+		item.Extensions = self.extensions(name, item.Extensions)
+		return true
+	}
+
+	switch prefix {
 	case "":
 		return false
 	case "dc":
@@ -638,6 +956,28 @@ func (self *Parser) parseItemExt(name string, item *Item) bool {
 		item.ITunesExt = self.itunesItem(item.ITunesExt)
 	case "media":
 		item.Media = self.media(item.Media)
+	case "co":
+		item.Company = self.company(item.Company)
+	case "taxo":
+		item.Taxonomy = self.taxonomy(item.Taxonomy)
+	case "pingback":
+		item.Pingback = self.pingback(item.Pingback)
+	case "ref":
+		item.Reference = self.reference(item.Reference)
+	case "str":
+		item.Streaming = self.streaming(item.Streaming)
+	case "search":
+		item.Search = self.search(item.Search)
+	case "foaf":
+		item.FOAF = self.foaf(item.FOAF)
+	case "email":
+		item.Email = self.email(item.Email)
+	case "ss":
+		item.ServiceStatus = self.serviceStatus(item.ServiceStatus)
+	case "georss":
+		item.GeoRSS = self.geoRSS(item.GeoRSS)
+	case "podcast":
+		item.Podcast = self.podcastItem(item.Podcast)
 	case "atom", "atom10", "atom03":
 		item.AtomExt = self.atomEntry(item.AtomExt)
 	default:
@@ -670,3 +1010,35 @@ func (self *Parser) media(item *ext.Media) *ext.Media {
 	}
 	return item
 }
+
+func (self *Parser) appendUnknown(name string, unknown []ext.Extension,
+) []ext.Extension {
+	if custom, ok := self.customElement(name); ok {
+		return append(unknown, custom)
+	}
+	return unknown
+}
+
+func (self *Parser) customElement(name string) (ext.Extension, bool) {
+	if self.opts.SkipUnknownElements {
+		self.p.Skip(name)
+		return ext.Extension{}, false
+	}
+
+	custom := ext.Extension{Name: self.p.Name, Attrs: emptyAttrs}
+	// Copy attributes
+	if n := len(self.p.Attrs); n != 0 {
+		custom.Attrs = make(map[string]string, n)
+		maps.Insert(custom.Attrs, self.p.AttributeSeq())
+	}
+
+	err := self.p.WithText(name, nil, func(s string) error {
+		custom.Value = s
+		return nil
+	})
+	if err != nil {
+		self.err = err
+		return custom, false
+	}
+	return custom, true
+}
@@ -3,12 +3,14 @@ package rss_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,6 +42,46 @@ func BenchmarkParse(b *testing.B) {
 	}
 }
 
+func BenchmarkParse_customElements(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString(`<rss version="2.0"><channel><title>custom</title>`)
+	for i := range 500 {
+		fmt.Fprintf(&sb, `<item><title>item %d</title>`, i)
+		for j := range 10 {
+			fmt.Fprintf(&sb, `<custom%d id="%d">value %d</custom%d>`, j, i, j, j)
+		}
+		sb.WriteString(`</item>`)
+	}
+	sb.WriteString(`</channel></rss>`)
+	data := []byte(sb.String())
+
+	tests := []struct {
+		name string
+		opts []options.Option
+	}{
+		{name: "default"},
+		{
+			name: "WithoutCustomExtensions",
+			opts: []options.Option{options.WithoutCustomExtensions(true)},
+		},
+	}
+
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			var bytesReader bytes.Reader
+			opts := append([]options.Option{options.WithStrictChars(true)},
+				tt.opts...)
+
+			b.ReportAllocs()
+			for b.Loop() {
+				var parser rss.Parser
+				bytesReader.Reset(data)
+				parser.Parse(&bytesReader, opts...)
+			}
+		})
+	}
+}
+
 func TestParser_Parse(t *testing.T) {
 	processTestFiles(t, "testdata", nil)
 }
@@ -98,3 +140,240 @@ func TestParser_Parse_withSkipUnknownElements(t *testing.T) {
 			return rss.NewParser().Parse(r, options.WithSkipUnknownElements(true))
 		})
 }
+
+func TestFeed_VersionMajorMinor(t *testing.T) {
+	tests := []struct {
+		file  string
+		major int
+		minor int
+	}{
+		{"version_rss_091_netscape.xml", 0, 91},
+		{"version_rss_091_userland.xml", 0, 91},
+		{"version_rss_092.xml", 0, 92},
+		{"version_rss_092_spaces.xml", 0, 92},
+		{"version_rss_093.xml", 0, 93},
+		{"version_rss_094.xml", 0, 94},
+		{"version_rss_2.xml", 2, 0},
+		{"version_rss_20.xml", 2, 0},
+		{"version_rdf_10.xml", 1, 0},
+		{"version_rss_missing.xml", 0, 0},
+		{"version_rss_missing_091.xml", 0, 91},
+		{"version_rss_missing_093.xml", 0, 93},
+		{"version_rss_missing_textInput.xml", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, err := os.ReadFile(path.Join("testdata", tt.file))
+			require.NoError(t, err)
+
+			feed, err := rss.NewParser().Parse(bytes.NewReader(data))
+			require.NoError(t, err)
+
+			major, minor := feed.VersionMajorMinor()
+			assert.Equal(t, tt.major, major)
+			assert.Equal(t, tt.minor, minor)
+		})
+	}
+}
+
+func TestParser_Parse_withUnknownElementsSeparate(t *testing.T) {
+	processTestFiles(t, "testdata/unknown_elements_separate",
+		func(r io.Reader) (*rss.Feed, error) {
+			return rss.NewParser().Parse(r,
+				options.WithUnknownElementsSeparate(true))
+		})
+}
+
+func TestParser_Parse_withoutCustomExtensions(t *testing.T) {
+	processTestFiles(t, "testdata/without_custom_extensions",
+		func(r io.Reader) (*rss.Feed, error) {
+			return rss.NewParser().Parse(r, options.WithoutCustomExtensions(true))
+		})
+}
+
+func TestParser_Parse_lenient(t *testing.T) {
+	processTestFiles(t, "testdata/lenient",
+		func(r io.Reader) (*rss.Feed, error) {
+			return rss.NewParser().Parse(r, options.WithLenient(true))
+		})
+}
+
+func TestParser_Parse_withCleanCDATA(t *testing.T) {
+	processTestFiles(t, "testdata/clean_cdata",
+		func(r io.Reader) (*rss.Feed, error) {
+			return rss.NewParser().Parse(r, options.WithCleanCDATA(true))
+		})
+
+	f, err := os.Open("testdata/clean_cdata/rss_channel_item_residual_cdata.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feed, err := rss.NewParser().Parse(f)
+	require.NoError(t, err)
+	assert.Equal(t, "<![CDATA[Feed Title]]>", feed.Title)
+}
+
+func TestParser_Parse_withStrictNamespaces(t *testing.T) {
+	const feedData = `<rss version="2.0"><channel>
+<title>Podcast</title>
+<itunes:subtitle>Subtitle</itunes:subtitle>
+<item>
+  <title>Episode</title>
+  <itunes:episode>1</itunes:episode>
+</item>
+</channel></rss>`
+
+	feed, err := rss.NewParser().Parse(strings.NewReader(feedData))
+	require.NoError(t, err)
+	require.NotNil(t, feed.ITunesExt)
+	assert.Equal(t, "Subtitle", feed.ITunesExt.Subtitle)
+	require.Len(t, feed.Items, 1)
+	require.NotNil(t, feed.Items[0].ITunesExt)
+	assert.Equal(t, "1", feed.Items[0].ITunesExt.Episode)
+
+	feed, err = rss.NewParser().Parse(strings.NewReader(feedData),
+		options.WithStrictNamespaces(true))
+	require.NoError(t, err)
+	assert.Nil(t, feed.ITunesExt)
+	assert.Equal(t, "Subtitle", feed.Extensions["_custom"]["subtitle"][0].Value)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+	assert.Nil(t, item.ITunesExt)
+	assert.Equal(t, "1", item.Extensions["_custom"]["episode"][0].Value)
+
+	const declared = `<rss version="2.0"
+  xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"><channel>
+<itunes:subtitle>Subtitle</itunes:subtitle>
+</channel></rss>`
+
+	feed, err = rss.NewParser().Parse(strings.NewReader(declared),
+		options.WithStrictNamespaces(true))
+	require.NoError(t, err)
+	require.NotNil(t, feed.ITunesExt)
+	assert.Equal(t, "Subtitle", feed.ITunesExt.Subtitle)
+}
+
+func TestParser_Parse_charsetFallback(t *testing.T) {
+	processTestFiles(t, "testdata/charset_fallback",
+		func(r io.Reader) (*rss.Feed, error) {
+			return rss.NewParser().Parse(r, options.WithCharsetFallback(true))
+		})
+
+	f, err := os.Open("testdata/charset_fallback/rss_unknown_charset.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	p := rss.NewParser()
+	_, err = p.Parse(f)
+	require.Error(t, err)
+	assert.Empty(t, p.Warnings())
+
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	_, err = p.Parse(f, options.WithCharsetFallback(true))
+	require.NoError(t, err)
+	require.Len(t, p.Warnings(), 1)
+	assert.ErrorContains(t, p.Warnings()[0], "x-unknown-charset")
+}
+
+func TestParser_Parse_withItemsSince(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	processTestFiles(t, "testdata/items_since",
+		func(r io.Reader) (*rss.Feed, error) {
+			return rss.NewParser().Parse(r, options.WithItemsSince(since))
+		})
+}
+
+func TestParser_Parse_withValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		image    string
+		warnings int
+	}{
+		{"same link", "http://example.org/", 0},
+		{"without trailing slash", "http://example.org", 0},
+		{"another link", "http://example.com/", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := `<rss version="2.0"><channel>
+<link>http://example.org/</link>
+<image><url>http://example.org/logo.png</url><link>` + tt.image + `</link></image>
+</channel></rss>`
+
+			p := rss.NewParser()
+			_, err := p.Parse(strings.NewReader(feed))
+			require.NoError(t, err)
+			assert.Empty(t, p.Warnings())
+
+			_, err = p.Parse(strings.NewReader(feed), options.WithValidate(true))
+			require.NoError(t, err)
+			require.Len(t, p.Warnings(), tt.warnings)
+			if tt.warnings != 0 {
+				assert.ErrorContains(t, p.Warnings()[0], tt.image)
+			}
+		})
+	}
+}
+
+func TestParser_Parse_withValidate_newFeedURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		newFeedURL string
+		warnings   int
+	}{
+		{"absolute", "https://example.com/feed.xml", 0},
+		{"relative", "/feed.xml", 1},
+		{"without scheme", "example.com/feed.xml", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := `<rss version="2.0"
+  xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"><channel>
+<itunes:new-feed-url>` + tt.newFeedURL + `</itunes:new-feed-url>
+</channel></rss>`
+
+			p := rss.NewParser()
+			_, err := p.Parse(strings.NewReader(feed), options.WithValidate(true))
+			require.NoError(t, err)
+			require.Len(t, p.Warnings(), tt.warnings)
+			if tt.warnings != 0 {
+				assert.ErrorContains(t, p.Warnings()[0], tt.newFeedURL)
+			}
+		})
+	}
+}
+
+func TestParser_Parse_withElementNameMapper(t *testing.T) {
+	const feedData = `<rss version="2.0"><channel>
+<item>
+  <title>Item Title</title>
+  <pubDate2>Tue, 02 Jan 2024 10:00:00 GMT</pubDate2>
+</item>
+</channel></rss>`
+
+	feed, err := rss.NewParser().Parse(strings.NewReader(feedData))
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.Empty(t, feed.Items[0].PubDate)
+
+	feed, err = rss.NewParser().Parse(strings.NewReader(feedData),
+		options.WithElementNameMapper(func(name string) string {
+			if name == "pubDate2" {
+				return "pubDate"
+			}
+			return name
+		}))
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+	assert.Equal(t, "Item Title", item.Title)
+	assert.Equal(t, "Tue, 02 Jan 2024 10:00:00 GMT", item.PubDate)
+	require.NotNil(t, item.PubDateParsed)
+	assert.Equal(t, time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC),
+		item.PubDateParsed.UTC())
+	assert.Empty(t, item.Extensions)
+}
@@ -0,0 +1,175 @@
+package gofeed
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	xpp "github.com/dsh2dsh/goxpp/v2"
+
+	"github.com/dsh2dsh/gofeed/v2/internal/xml"
+	"github.com/dsh2dsh/gofeed/v2/options"
+)
+
+// ParseSummary returns title of the feed and number of its items, without full
+// parsing of the feed. It detects type of the feed by its beginning, like
+// [options.WithStreaming] does, and scans the feed by the pull parser, skipping
+// everything except the title and item start tags. It doesn't build any
+// structs for the feed and its items, so it allocates much less memory than
+// [Parser.Parse], like for listing of many feeds on a dashboard. XML feeds are
+// still tokenized completely. Options, which filter or transform items, like
+// [options.WithItemsSince], don't affect the number of items.
+func (f *Parser) ParseSummary(r io.Reader, opts ...options.Option,
+) (title string, itemCount int, ft FeedType, err error) {
+	f.opts.Apply(opts...)
+	ctx, cancel := f.timeoutContext()
+	defer cancel()
+
+	ft, r, err = detectFeedReader(withContext(ctx, r), &f.opts)
+	if err != nil {
+		return "", 0, ft, fmt.Errorf("%w: %w", ErrFeedTypeNotDetected, err)
+	}
+
+	switch ft {
+	case FeedTypeRSS:
+		title, itemCount, err = xmlSummary(r, "item", &f.opts, "")
+	case FeedTypeAtom:
+		title, itemCount, err = xmlSummary(r, "entry", &f.opts,
+			"", "atom", "atom10", "atom03")
+	case FeedTypeJSON:
+		title, itemCount, err = jsonSummary(r)
+	default:
+		return "", 0, ft, ErrFeedTypeNotDetected
+	}
+
+	if err != nil {
+		return "", 0, ft, fmt.Errorf("gofeed: parse summary: %w", err)
+	}
+	return title, itemCount, ft, nil
+}
+
+// summaryScanner scans XML feed for its title and items.
+type summaryScanner struct {
+	p        *xml.Parser
+	item     string
+	prefixes []string
+
+	title string
+	count int
+}
+
+// xmlSummary returns title and number of items of RSS or Atom feed. Items are
+// elements with name item and namespace prefix from prefixes.
+func xmlSummary(r io.Reader, item string, opts *options.Parse,
+	prefixes ...string,
+) (string, int, error) {
+	p := xml.NewParser(r, options.From(*opts))
+	if _, err := p.FindRoot(); err != nil {
+		return "", 0, xml.CategorizeErr(err)
+	}
+
+	self := summaryScanner{p: p, item: item, prefixes: prefixes}
+	if err := self.scan(); err != nil {
+		return "", 0, xml.CategorizeErr(err)
+	}
+	return self.title, self.count, nil
+}
+
+// scan scans children of current element, descending into <channel> of RSS
+// feed.
+func (self *summaryScanner) scan() error {
+	for {
+		event, err := self.p.Next()
+		if err != nil {
+			return err
+		} else if event == xpp.EndTag {
+			return nil
+		}
+
+		name := strings.ToLower(self.p.Name)
+		var core bool
+		for _, prefix := range self.prefixes {
+			if self.p.ExtensionPrefix() == prefix {
+				core = true
+				break
+			}
+		}
+
+		switch {
+		case core && name == self.item:
+			self.count++
+			self.p.Skip(name)
+		case core && name == "title" && self.title == "":
+			// Including text of children, like xhtml title of Atom feed.
+			self.title = self.p.DeepText()
+		case core && name == "channel":
+			if err := self.scan(); err != nil {
+				return err
+			}
+		default:
+			self.p.Skip(name)
+		}
+
+		if err := self.p.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// jsonSummary returns title and number of items of JSON feed, reading it token
+// by token.
+func jsonSummary(r io.Reader) (title string, count int, err error) {
+	d := json.NewDecoder(r)
+	if err := expectDelim(d, '{'); err != nil {
+		return "", 0, err
+	}
+
+	for d.More() {
+		t, err := d.Token()
+		if err != nil {
+			return "", 0, fmt.Errorf("read json key: %w", err)
+		}
+
+		switch t {
+		case "title":
+			if err := d.Decode(&title); err != nil {
+				return "", 0, fmt.Errorf("read json title: %w", err)
+			}
+		case "items":
+			if count, err = countJSONItems(d); err != nil {
+				return "", 0, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := d.Decode(&skip); err != nil {
+				return "", 0, fmt.Errorf("skip json value: %w", err)
+			}
+		}
+	}
+	return title, count, expectDelim(d, '}')
+}
+
+func countJSONItems(d *json.Decoder) (count int, err error) {
+	if err := expectDelim(d, '['); err != nil {
+		return 0, err
+	}
+
+	for ; d.More(); count++ {
+		var skip json.RawMessage
+		if err := d.Decode(&skip); err != nil {
+			return 0, fmt.Errorf("skip json item: %w", err)
+		}
+	}
+	return count, expectDelim(d, ']')
+}
+
+func expectDelim(d *json.Decoder, delim json.Delim) error {
+	t, err := d.Token()
+	if err != nil {
+		return fmt.Errorf("read json %q: %w", delim, err)
+	} else if t != delim {
+		return fmt.Errorf("unexpected json token %v, want %q", t, delim)
+	}
+	return nil
+}
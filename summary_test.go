@@ -0,0 +1,135 @@
+package gofeed_test
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dsh2dsh/gofeed/v2"
+)
+
+func TestParser_ParseSummary(t *testing.T) {
+	tests := []struct {
+		file     string
+		feedType gofeed.FeedType
+	}{
+		{"atom03_feed.xml", gofeed.FeedTypeAtom},
+		{"atom10_feed.xml", gofeed.FeedTypeAtom},
+		{"rss_feed.xml", gofeed.FeedTypeRSS},
+		{"rdf_feed.xml", gofeed.FeedTypeRSS},
+		{"rss_feed_latin1.xml", gofeed.FeedTypeRSS},
+		{"rss_itunes_serial.xml", gofeed.FeedTypeRSS},
+		{"json10_feed.json", gofeed.FeedTypeJSON},
+		{"json11_feed.json", gofeed.FeedTypeJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			b, err := os.ReadFile(path.Join("testdata/parser/", tt.file))
+			require.NoError(t, err)
+
+			feed, err := gofeed.NewParser().Parse(bytes.NewReader(b))
+			require.NoError(t, err)
+
+			title, itemCount, feedType, err := gofeed.NewParser().
+				ParseSummary(bytes.NewReader(b))
+			require.NoError(t, err)
+			assert.Equal(t, tt.feedType, feedType)
+			assert.Equal(t, feed.Title, title)
+			assert.Equal(t, len(feed.Items), itemCount)
+		})
+	}
+}
+
+func TestParser_ParseSummary_items(t *testing.T) {
+	tests := []struct {
+		name  string
+		feed  string
+		title string
+		count int
+	}{
+		{
+			name: "rss",
+			feed: `<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+<channel><media:title>Media</media:title>
+<image><title>Image</title></image><title>Feed</title>
+<item><title>1</title><media:item/></item><item/><item></item>
+</channel></rss>`,
+			title: "Feed",
+			count: 3,
+		},
+		{
+			name: "atom xhtml title",
+			feed: `<feed xmlns="http://www.w3.org/2005/Atom">
+<title type="xhtml"><div xmlns="http://www.w3.org/1999/xhtml">Feed <b>title</b></div></title>
+<entry><title>1</title></entry><entry><title>2</title></entry></feed>`,
+			title: "Feed title",
+			count: 2,
+		},
+		{
+			name: "json",
+			feed: `{"version": "https://jsonfeed.org/version/1.1",
+"authors": [{"name": "title"}], "title": "Feed",
+"items": [{"id": "1", "title": "1"}, {"id": "2", "items": [1, 2]}]}`,
+			title: "Feed",
+			count: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			title, itemCount, _, err := gofeed.NewParser().
+				ParseSummary(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, title)
+			assert.Equal(t, tt.count, itemCount)
+		})
+	}
+}
+
+func TestParser_ParseSummary_errors(t *testing.T) {
+	_, _, feedType, err := gofeed.NewParser().ParseSummary(
+		strings.NewReader(`<html><body>Not a feed</body></html>`))
+	require.ErrorIs(t, err, gofeed.ErrFeedTypeNotDetected)
+	assert.Equal(t, gofeed.FeedTypeUnknown, feedType)
+
+	_, _, feedType, err = gofeed.NewParser().ParseSummary(
+		strings.NewReader(`<rss version="2.0"><channel><item>`))
+	require.Error(t, err)
+	assert.Equal(t, gofeed.FeedTypeRSS, feedType)
+
+	_, _, feedType, err = gofeed.NewParser().ParseSummary(
+		strings.NewReader(`{"title": "Feed", "items": [{"id": "1"}`))
+	require.Error(t, err)
+	assert.Equal(t, gofeed.FeedTypeJSON, feedType)
+}
+
+func BenchmarkParser_ParseSummary(b *testing.B) {
+	data, err := os.ReadFile("rss/testdata/bench/large_rss.xml")
+	require.NoError(b, err)
+
+	b.Run("Parse", func(b *testing.B) {
+		var r bytes.Reader
+		b.ReportAllocs()
+		for b.Loop() {
+			r.Reset(data)
+			_, err := gofeed.NewParser().Parse(&r)
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("ParseSummary", func(b *testing.B) {
+		var r bytes.Reader
+		b.ReportAllocs()
+		for b.Loop() {
+			r.Reset(data)
+			_, _, _, err := gofeed.NewParser().ParseSummary(&r)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -1,11 +1,17 @@
 package gofeed
 
 import (
+	"cmp"
 	"errors"
+	"math"
+	"net/url"
 	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dsh2dsh/gofeed/v2/atom"
+	"github.com/dsh2dsh/gofeed/v2/ext"
 	"github.com/dsh2dsh/gofeed/v2/internal/shared"
 	"github.com/dsh2dsh/gofeed/v2/json"
 	"github.com/dsh2dsh/gofeed/v2/options"
@@ -18,6 +24,27 @@ type Translator interface {
 	Translate(feed any, opts *options.Parse) (*Feed, error)
 }
 
+// FromRSS converts already parsed RSS feed into the universal feed type, using
+// [DefaultRSSTranslator].
+func FromRSS(feed *rss.Feed) (*Feed, error) {
+	var t DefaultRSSTranslator
+	return t.Translate(feed, new(options.Parse))
+}
+
+// FromAtom converts already parsed Atom feed into the universal feed type,
+// using [DefaultAtomTranslator].
+func FromAtom(feed *atom.Feed) (*Feed, error) {
+	var t DefaultAtomTranslator
+	return t.Translate(feed, new(options.Parse))
+}
+
+// FromJSON converts already parsed JSON feed into the universal feed type,
+// using [DefaultJSONTranslator].
+func FromJSON(feed *json.Feed) (*Feed, error) {
+	var t DefaultJSONTranslator
+	return t.Translate(feed, new(options.Parse))
+}
+
 // DefaultRSSTranslator converts an rss.Feed struct
 // into the generic Feed struct.
 //
@@ -34,59 +61,106 @@ func (t *DefaultRSSTranslator) Translate(feed any, opts *options.Parse) (*Feed,
 		return nil, errors.New("Feed did not match expected type of *rss.Feed")
 	}
 
-	return &Feed{
-		Title:           rss.GetTitle(),
-		Description:     rss.GetDescription(),
-		Link:            rss.Link(),
-		Links:           slices.Collect(rss.LinkSeq()),
-		FeedLink:        rss.FeedLink(),
-		Updated:         rss.GetUpdated(),
-		UpdatedParsed:   rss.GetUpdatedParsed(),
-		Published:       rss.PubDate,
-		PublishedParsed: rss.PubDateParsed,
-		Author:          t.feedAuthor(rss),
-		Authors:         t.feedAuthors(rss),
-		Language:        rss.GetLanguage(),
-		Image:           t.feedImage(rss),
-		Copyright:       rss.GetCopyright(),
-		Generator:       rss.Generator,
-		Categories:      slices.Collect(rss.AllCategories()),
-		Items:           t.feedItems(rss),
-		AtomExt:         rss.AtomExt,
-		ITunesExt:       rss.ITunesExt,
-		DublinCoreExt:   rss.DublinCoreExt,
-		Extensions:      rss.Extensions,
-		FeedVersion:     rss.Version,
-		FeedType:        "rss",
-	}, nil
-}
-
-func (t *DefaultRSSTranslator) translateFeedItem(rssItem *rss.Item) *Item {
+	result := &Feed{
+		Title:            rss.GetTitle(),
+		Description:      rss.GetDescription(),
+		Link:             rss.Link(),
+		Links:            slices.Collect(rss.LinkSeq()),
+		FeedLink:         rss.FeedLink(),
+		Updated:          rss.GetUpdated(),
+		UpdatedParsed:    rss.GetUpdatedParsed(),
+		Published:        rss.PubDate,
+		PublishedParsed:  rss.PubDateParsed,
+		BuildDate:        rss.LastBuildDate,
+		BuildDateParsed:  rss.LastBuildDateParsed,
+		Author:           t.feedAuthor(rss),
+		Authors:          t.feedAuthors(rss),
+		Contributors:     dcContributors(rss.DublinCoreExt),
+		Language:         rss.GetLanguage(),
+		Image:            t.feedImage(rss),
+		Copyright:        rss.GetCopyright(),
+		Docs:             rss.Docs,
+		Generator:        rss.Generator,
+		GeneratorName:    rss.GeneratorName(),
+		GeneratorVersion: rss.GeneratorVersion(),
+		Categories:       slices.Collect(rss.AllCategories()),
+		Blocked:          rss.ITunesExt != nil && rss.ITunesExt.IsBlocked(),
+		Complete:         rss.ITunesExt != nil && rss.ITunesExt.IsComplete(),
+		NewFeedURL:       t.feedNewFeedURL(rss),
+		Items:            t.feedItems(rss, opts),
+		AtomExt:          rss.AtomExt,
+		ITunesExt:        rss.ITunesExt,
+		SyndicationExt:   rss.Syndication(),
+		DublinCoreExt:    rss.DublinCoreExt,
+		Extensions:       rss.Extensions,
+		UnknownElements:  rss.UnknownElements,
+		FeedVersion:      rss.Version,
+		FeedType:         "rss",
+	}
+	resolveItemLinks(result, opts)
+	return result, nil
+}
+
+func (t *DefaultRSSTranslator) translateFeedItem(rssItem *rss.Item,
+	opts *options.Parse,
+) *Item {
 	item := &Item{
-		Title:           rssItem.GetTitle(),
-		Description:     rssItem.GetDescription(),
-		Content:         rssItem.GetContent(),
-		Links:           rssItem.Links,
-		Updated:         rssItem.GetUpdated(),
-		UpdatedParsed:   rssItem.GetUpdatedParsed(),
-		Published:       rssItem.GetPublished(),
-		PublishedParsed: rssItem.GetPublishedParsed(),
-		Author:          t.itemAuthor(rssItem),
-		Authors:         t.itemAuthors(rssItem),
-		GUID:            rssItem.GetGUID(),
-		Image:           t.itemImage(rssItem),
-		Categories:      slices.Collect(rssItem.AllCategories()),
-		Enclosures:      t.itemEnclosures(rssItem),
-		AtomExt:         rssItem.AtomExt,
-		DublinCoreExt:   rssItem.DublinCoreExt,
-		ITunesExt:       rssItem.ITunesExt,
-		Extensions:      rssItem.Extensions,
+		Title:            rssItem.GetTitle(),
+		Description:      rssItem.GetDescription(),
+		Content:          rssItem.GetContent(),
+		Links:            rssItem.Links,
+		RelatedLinks:     rssItem.RelatedLinks(),
+		LinkDetails:      t.itemLinkDetails(rssItem),
+		Updated:          rssItem.GetUpdated(),
+		UpdatedParsed:    rssItem.GetUpdatedParsed(),
+		Published:        rssItem.GetPublished(),
+		PublishedParsed:  rssItem.GetPublishedParsed(),
+		Author:           t.itemAuthor(rssItem),
+		Authors:          t.itemAuthors(rssItem),
+		Contributors:     dcContributors(rssItem.DublinCoreExt),
+		GUID:             rssItem.GetGUID(),
+		Language:         rssItem.GetLanguage(),
+		Image:            t.itemImage(rssItem),
+		Categories:       slices.Collect(rssItem.AllCategories()),
+		Keywords:         t.itemKeywords(rssItem),
+		Adult:            t.itemAdult(rssItem),
+		Duration:         t.itemDuration(rssItem),
+		Season:           t.itemSeason(rssItem),
+		Episode:          t.itemEpisode(rssItem),
+		SeasonName:       t.itemSeasonName(rssItem),
+		EpisodeName:      t.itemEpisodeName(rssItem),
+		Enclosures:       t.itemEnclosures(rssItem),
+		Source:           t.itemSource(rssItem),
+		AtomExt:          rssItem.AtomExt,
+		DublinCoreExt:    rssItem.DublinCoreExt,
+		ITunesExt:        rssItem.ITunesExt,
+		EmailExt:         rssItem.Email,
+		ServiceStatusExt: rssItem.ServiceStatus,
+		GeoExt:           rssItem.GeoRSS,
+		PodcastExt:       rssItem.Podcast,
+		Extensions:       rssItem.Extensions,
+		UnknownElements:  rssItem.UnknownElements,
 	}
 
 	if len(item.Links) != 0 {
 		item.Link = item.Links[0]
 	}
-	return item
+	return withContentFallback(item, opts,
+		func(source options.ContentSource) string {
+			return t.itemContentFrom(rssItem, source)
+		})
+}
+
+func (t *DefaultRSSTranslator) itemLinkDetails(rssItem *rss.Item) []Link {
+	var links []Link
+	for _, href := range rssItem.Links {
+		links = append(links, Link{Href: href, Rel: "alternate"})
+	}
+
+	if rssItem.AtomExt != nil {
+		links = atomLinkDetails(links, rssItem.AtomExt.Links)
+	}
+	return links
 }
 
 func (t *DefaultRSSTranslator) feedAuthor(rss *rss.Feed) *Person {
@@ -100,31 +174,68 @@ func (t *DefaultRSSTranslator) feedAuthor(rss *rss.Feed) *Person {
 }
 
 func (t *DefaultRSSTranslator) feedAuthors(rss *rss.Feed) []*Person {
+	var authors []*Person
 	if author := t.feedAuthor(rss); author != nil {
-		return []*Person{author}
+		authors = append(authors, author)
 	}
-	return nil
+	return appendFOAFPersons(authors, rss.FOAF)
 }
 
 func (t *DefaultRSSTranslator) feedImage(rss *rss.Feed) *Image {
 	if img := rss.GetImage(); img != nil {
-		return &Image{Title: img.Title, URL: img.URL}
+		return &Image{Title: img.Title, URL: img.URL, Link: img.Link}
 	}
 	return nil
 }
 
-func (t *DefaultRSSTranslator) feedItems(rss *rss.Feed) []*Item {
+func (t *DefaultRSSTranslator) feedItems(rss *rss.Feed, opts *options.Parse,
+) []*Item {
 	if len(rss.Items) == 0 {
 		return nil
 	}
 
+	lang := rss.GetLanguage()
 	items := make([]*Item, len(rss.Items))
 	for i, item := range rss.Items {
-		items[i] = t.translateFeedItem(item)
+		items[i] = t.translateFeedItem(item, opts)
+		items[i].Index = i
+		if items[i].Language == "" {
+			items[i].Language = lang
+		}
 	}
 	return items
 }
 
+// itemContentFrom returns text of rssItem from given source of
+// [options.Parse.ContentFallbackChain].
+func (t *DefaultRSSTranslator) itemContentFrom(rssItem *rss.Item,
+	source options.ContentSource,
+) string {
+	switch source {
+	case options.ContentSourceContent:
+		return rssItem.GetContent()
+	case options.ContentSourceDescription:
+		return rssItem.Description
+	case options.ContentSourceDublinCore:
+		if dc := rssItem.DublinCoreExt; dc != nil {
+			return dc.Description
+		}
+	case options.ContentSourceAtom:
+		if entry := rssItem.AtomExt; entry != nil {
+			return entry.Summary
+		}
+	case options.ContentSourceITunes:
+		if itunes := rssItem.ITunesExt; itunes != nil {
+			return cmp.Or(itunes.Summary, itunes.Subtitle)
+		}
+	case options.ContentSourceMedia:
+		if media := rssItem.Media; media != nil {
+			return media.Description()
+		}
+	}
+	return ""
+}
+
 func (t *DefaultRSSTranslator) itemAuthor(rssItem *rss.Item) *Person {
 	if name, address, ok := rssItem.GetAuthor(); ok {
 		return &Person{
@@ -132,12 +243,164 @@ func (t *DefaultRSSTranslator) itemAuthor(rssItem *rss.Item) *Person {
 			Email: address,
 		}
 	}
-	return nil
+	return emailPerson(rssItem.Email)
 }
 
 func (t *DefaultRSSTranslator) itemAuthors(rssItem *rss.Item) []*Person {
+	var authors []*Person
 	if author := t.itemAuthor(rssItem); author != nil {
-		return []*Person{author}
+		authors = append(authors, author)
+	}
+	authors = appendITunesAuthor(authors, rssItem.ITunesExt)
+	return appendFOAFPersons(authors, rssItem.FOAF)
+}
+
+// appendITunesAuthor appends itunes:author of the item to authors, unless an
+// author with the same name is already added, like when itunes:author is the
+// only author of the item. It keeps both, for instance, a guest of an episode
+// as item author and a host of the show as itunes:author.
+func appendITunesAuthor(authors []*Person, itunes *ext.ITunesItemExtension,
+) []*Person {
+	if itunes == nil || strings.TrimSpace(itunes.Author) == "" {
+		return authors
+	}
+
+	name, address := shared.ParseNameAddress(itunes.Author)
+	samePerson := slices.ContainsFunc(authors, func(a *Person) bool {
+		if name != "" {
+			return strings.EqualFold(a.Name, name)
+		}
+		return strings.EqualFold(a.Email, address)
+	})
+	if samePerson {
+		return authors
+	}
+	return append(authors, &Person{Name: name, Email: address})
+}
+
+// dcContributors returns dc:contributor as a list of one person.
+func dcContributors(dc *ext.DublinCoreExtension) []*Person {
+	if dc == nil || strings.TrimSpace(dc.Contributor) == "" {
+		return nil
+	}
+
+	name, address := shared.ParseNameAddress(dc.Contributor)
+	return []*Person{{Name: name, Email: address}}
+}
+
+// appendFOAFPersons appends persons of foaf to authors, skipping persons with
+// the same name as already added authors.
+func appendFOAFPersons(authors []*Person, foaf *ext.FOAFExtension) []*Person {
+	if foaf == nil {
+		return authors
+	}
+
+	for i := range foaf.Persons {
+		p := &foaf.Persons[i]
+		name := strings.TrimSpace(p.Name)
+		sameName := slices.ContainsFunc(authors, func(a *Person) bool {
+			return name != "" && strings.EqualFold(a.Name, name)
+		})
+		if !sameName {
+			authors = append(authors, &Person{
+				Name:  name,
+				Email: p.Email(),
+				URL:   p.Homepage,
+			})
+		}
+	}
+	return authors
+}
+
+func (t *DefaultRSSTranslator) itemKeywords(rssItem *rss.Item) []string {
+	if rssItem.ITunesExt == nil {
+		return nil
+	}
+	return rssItem.ITunesExt.KeywordList()
+}
+
+// itemAdult returns adult flag of the item from its media:rating, or from
+// itunes:explicit if media isn't rated. It returns nil if there is neither.
+// media:restriction isn't a signal, because it restricts media by country or
+// sharing, not by audience.
+func (t *DefaultRSSTranslator) itemAdult(rssItem *rss.Item) *bool {
+	if media := rssItem.Media; media != nil {
+		if adult, ok := media.Adult(); ok {
+			return &adult
+		}
+	}
+
+	if itunes := rssItem.ITunesExt; itunes != nil {
+		if explicit, ok := itunes.IsExplicit(); ok {
+			return &explicit
+		}
+	}
+	return nil
+}
+
+// itemDuration returns duration of the item from itunes:duration, or from
+// duration of media:content if itunes:duration is absent.
+func (t *DefaultRSSTranslator) itemDuration(rssItem *rss.Item) time.Duration {
+	if itunes := rssItem.ITunesExt; itunes != nil {
+		if d := itunes.ParsedDuration(); d > 0 {
+			return d
+		}
+	}
+
+	if media := rssItem.Media; media != nil {
+		return media.Duration()
+	}
+	return 0
+}
+
+func (t *DefaultRSSTranslator) itemSeason(rssItem *rss.Item) *int {
+	if itunes := rssItem.ITunesExt; itunes != nil {
+		if n, ok := itunes.SeasonNumber(); ok {
+			return &n
+		}
+	}
+
+	if p := rssItem.Podcast; p != nil && p.Season != nil {
+		n := p.Season.Number
+		return &n
+	}
+	return nil
+}
+
+func (t *DefaultRSSTranslator) itemEpisode(rssItem *rss.Item) *int {
+	if itunes := rssItem.ITunesExt; itunes != nil {
+		if n, ok := itunes.EpisodeNumber(); ok {
+			return &n
+		}
+	}
+
+	// Fractional podcast:episode, like 204.5, can't be an integer episode.
+	if p := rssItem.Podcast; p != nil && p.Episode != nil {
+		if n := p.Episode.Number; n == math.Trunc(n) && n <= math.MaxInt32 {
+			episode := int(n)
+			return &episode
+		}
+	}
+	return nil
+}
+
+func (t *DefaultRSSTranslator) itemSeasonName(rssItem *rss.Item) string {
+	if p := rssItem.Podcast; p != nil && p.Season != nil {
+		return p.Season.Name
+	}
+	return ""
+}
+
+func (t *DefaultRSSTranslator) itemEpisodeName(rssItem *rss.Item) string {
+	if p := rssItem.Podcast; p != nil && p.Episode != nil {
+		return p.Episode.Display
+	}
+	return ""
+}
+
+func (t *DefaultRSSTranslator) itemSource(rssItem *rss.Item) *Source {
+	if s := rssItem.Source; s != nil && (s.Title != "" || s.URL != "") {
+		return &Source{Title: s.Title, FeedLink: s.URL}
 	}
 	return nil
 }
@@ -149,6 +412,13 @@ func (t *DefaultRSSTranslator) itemImage(rssItem *rss.Item) *Image {
 	return nil
 }
 
+func (t *DefaultRSSTranslator) feedNewFeedURL(rss *rss.Feed) string {
+	if rss.ITunesExt == nil {
+		return ""
+	}
+	return strings.TrimSpace(rss.ITunesExt.NewFeedURL)
+}
+
 func (t *DefaultRSSTranslator) itemEnclosures(rssItem *rss.Item) []*Enclosure {
 	enc := rssItem.Enclosure
 	if enc == nil {
@@ -180,68 +450,100 @@ func (t *DefaultAtomTranslator) Translate(feed any, opts *options.Parse) (*Feed,
 		return nil, errors.New("Feed did not match expected type of *atom.Feed")
 	}
 
-	return &Feed{
-		Title:         atom.Title,
-		Description:   atom.Subtitle,
-		Link:          atom.GetLink(),
-		FeedLink:      atom.GetFeedLink(),
-		Links:         atom.GetLinks(),
-		Updated:       atom.Updated,
-		UpdatedParsed: atom.UpdatedParsed,
-		Author:        t.feedAuthor(atom),
-		Authors:       t.feedAuthors(atom),
-		Language:      atom.Language,
-		Image:         t.feedImage(atom),
-		Copyright:     atom.Rights,
-		Categories:    atom.GetCategories(),
-		Generator:     atom.GetGenerator(),
-		Items:         t.feedItems(atom),
-		Extensions:    atom.Extensions,
-		FeedVersion:   atom.Version,
-		FeedType:      "atom",
-	}, nil
-}
-
-func (t *DefaultAtomTranslator) feedItem(entry *atom.Entry) *Item {
-	return &Item{
+	result := &Feed{
+		Title:            atom.Title,
+		TitleType:        atomTitleType(atom.TitleHTML()),
+		Description:      atom.Subtitle,
+		Link:             atom.GetLink(),
+		FeedLink:         atom.GetFeedLink(),
+		Links:            atom.GetLinks(),
+		Updated:          atom.Updated,
+		UpdatedParsed:    atom.UpdatedParsed,
+		Author:           t.feedAuthor(atom),
+		Authors:          t.feedAuthors(atom),
+		Contributors:     atomPersons(atom.Contributors),
+		Language:         atom.Language,
+		Image:            t.feedImage(atom),
+		Copyright:        atom.Rights,
+		Categories:       atom.GetCategories(),
+		Generator:        atom.GetGenerator(),
+		GeneratorName:    atom.GeneratorName(),
+		GeneratorVersion: atom.GeneratorVersion(),
+		Items:            t.feedItems(atom, opts),
+		Extensions:       atom.Extensions,
+		UnknownElements:  atom.UnknownElements,
+		FeedVersion:      atom.Version,
+		FeedType:         "atom",
+	}
+	resolveItemLinks(result, opts)
+	return result, nil
+}
+
+func (t *DefaultAtomTranslator) feedItem(entry *atom.Entry,
+	opts *options.Parse,
+) *Item {
+	item := &Item{
 		Title:           entry.Title,
+		TitleType:       atomTitleType(entry.TitleHTML()),
 		Description:     entry.Summary,
 		Content:         entry.GetContent(),
 		Link:            entry.GetLink(),
 		Links:           entry.GetLinks(),
+		RelatedLinks:    entry.RelatedLinks(),
+		LinkDetails:     atomLinkDetails(nil, entry.Links),
+		EditURL:         entry.EditLink(),
+		CommentsFeedURL: entry.RepliesLink(),
+		CommentCount:    t.itemCommentCount(entry),
 		Updated:         entry.Updated,
 		UpdatedParsed:   entry.UpdatedParsed,
 		Published:       entry.GetPublished(),
 		PublishedParsed: entry.GetPublishedParsed(),
 		Author:          t.itemAuthor(entry),
 		Authors:         t.itemAuthors(entry),
+		Contributors:    atomPersons(entry.Contributors),
 		GUID:            entry.ID,
+		Language:        entry.Language,
 		Categories:      entry.GetCategories(),
 		Enclosures:      t.itemEnclosures(entry),
+		Source:          t.itemSource(entry),
 		Extensions:      entry.Extensions,
+		UnknownElements: entry.UnknownElements,
+	}
+	return withContentFallback(item, opts,
+		func(source options.ContentSource) string {
+			return t.itemContentFrom(entry, source)
+		})
+}
+
+// itemContentFrom returns text of entry from given source of
+// [options.Parse.ContentFallbackChain].
+func (t *DefaultAtomTranslator) itemContentFrom(entry *atom.Entry,
+	source options.ContentSource,
+) string {
+	switch source {
+	case options.ContentSourceContent:
+		if entry.Content != nil {
+			return entry.Content.Value
+		}
+	case options.ContentSourceDescription, options.ContentSourceAtom:
+		return entry.Summary
+	case options.ContentSourceMedia:
+		if media := entry.Media; media != nil {
+			return media.Description()
+		}
 	}
+	return ""
 }
 
 func (t *DefaultAtomTranslator) feedAuthor(atom *atom.Feed) *Person {
 	if a := atom.GetAuthor(); a != nil {
-		return &Person{Name: a.Name, Email: a.Email}
+		return &Person{Name: a.Name, Email: a.Email, URL: a.URI}
 	}
 	return nil
 }
 
 func (t *DefaultAtomTranslator) feedAuthors(atom *atom.Feed) []*Person {
-	if len(atom.Authors) == 0 {
-		return nil
-	}
-
-	authors := make([]*Person, len(atom.Authors))
-	for i, a := range atom.Authors {
-		authors[i] = &Person{
-			Name:  a.Name,
-			Email: a.Email,
-		}
-	}
-	return authors
+	return atomPersons(atom.Authors)
 }
 
 func (t *DefaultAtomTranslator) feedImage(atom *atom.Feed) *Image {
@@ -251,31 +553,66 @@ func (t *DefaultAtomTranslator) feedImage(atom *atom.Feed) *Image {
 	return nil
 }
 
-func (t *DefaultAtomTranslator) feedItems(atom *atom.Feed) []*Item {
+func (t *DefaultAtomTranslator) feedItems(atom *atom.Feed,
+	opts *options.Parse,
+) []*Item {
 	items := make([]*Item, len(atom.Entries))
 	for i, entry := range atom.Entries {
-		items[i] = t.feedItem(entry)
+		items[i] = t.feedItem(entry, opts)
+		items[i].Index = i
+		if items[i].Language == "" {
+			items[i].Language = atom.Language
+		}
 	}
 	return items
 }
 
+func (t *DefaultAtomTranslator) itemCommentCount(entry *atom.Entry) *int {
+	if n, ok := entry.RepliesCount(); ok {
+		return &n
+	}
+	return nil
+}
+
 func (t *DefaultAtomTranslator) itemAuthor(entry *atom.Entry) *Person {
 	if a := entry.GetAuthor(); a != nil {
-		return &Person{Name: a.Name, Email: a.Email}
+		return &Person{Name: a.Name, Email: a.Email, URL: a.URI}
 	}
 	return nil
 }
 
 func (t *DefaultAtomTranslator) itemAuthors(entry *atom.Entry) []*Person {
-	if len(entry.Authors) == 0 {
+	return atomPersons(entry.Authors)
+}
+
+func atomPersons(persons []*atom.Person) []*Person {
+	if len(persons) == 0 {
 		return nil
 	}
 
-	authors := make([]*Person, len(entry.Authors))
-	for i, a := range entry.Authors {
-		authors[i] = &Person{Name: a.Name, Email: a.Email}
+	result := make([]*Person, len(persons))
+	for i, p := range persons {
+		result[i] = &Person{Name: p.Name, Email: p.Email, URL: p.URI}
 	}
-	return authors
+	return result
+}
+
+func (t *DefaultAtomTranslator) itemSource(entry *atom.Entry) *Source {
+	s := entry.Source
+	if s == nil {
+		return nil
+	}
+
+	source := &Source{
+		Title:    s.Title,
+		ID:       s.ID,
+		Link:     s.GetLink(),
+		FeedLink: s.GetFeedLink(),
+	}
+	if *source == (Source{}) {
+		return nil
+	}
+	return source
 }
 
 func (t *DefaultAtomTranslator) itemEnclosures(entry *atom.Entry) []*Enclosure {
@@ -297,6 +634,31 @@ func (t *DefaultAtomTranslator) itemEnclosures(entry *atom.Entry) []*Enclosure {
 	return enclosures
 }
 
+// atomTitleType returns universal type of atom title.
+func atomTitleType(html bool) string {
+	if html {
+		return "html"
+	}
+	return ""
+}
+
+// atomLinkDetails appends atom links to links and returns extended slice.
+func atomLinkDetails(links []Link, atomLinks []*atom.Link) []Link {
+	for _, l := range atomLinks {
+		if l.Href == "" {
+			continue
+		}
+		links = append(links, Link{
+			Href:     l.Href,
+			Rel:      l.Rel,
+			Type:     l.Type,
+			Hreflang: l.Hreflang,
+			Title:    l.Title,
+		})
+	}
+	return links
+}
+
 // DefaultJSONTranslator converts an json.Feed struct
 // into the generic Feed struct.
 //
@@ -313,7 +675,7 @@ func (t *DefaultJSONTranslator) Translate(feed any, opts *options.Parse) (*Feed,
 		return nil, errors.New("Feed did not match expected type of *json.Feed")
 	}
 
-	return &Feed{
+	result := &Feed{
 		FeedVersion:     json.Version,
 		Title:           json.Title,
 		Link:            json.HomePageURL,
@@ -321,10 +683,11 @@ func (t *DefaultJSONTranslator) Translate(feed any, opts *options.Parse) (*Feed,
 		Links:           json.GetLinks(),
 		Description:     json.Description,
 		Image:           t.feedImage(json),
+		Favicon:         json.Favicon,
 		Author:          t.feedAuthor(json),
 		Authors:         t.feedAuthors(json),
 		Language:        json.Language,
-		Items:           t.feedItems(json),
+		Items:           t.feedItems(json, opts),
 		Updated:         json.GetUpdated(),
 		UpdatedParsed:   json.GetUpdatedParsed(),
 		Published:       json.GetPublished(),
@@ -337,30 +700,53 @@ func (t *DefaultJSONTranslator) Translate(feed any, opts *options.Parse) (*Feed,
 		// TODO Exipred is missing in global Feed
 		// TODO Hubs is not supported in json.Feed
 		// TODO Extensions is not supported in json.Feed
-	}, nil
+	}
+	resolveItemLinks(result, opts)
+	return result, nil
 }
 
-func (t *DefaultJSONTranslator) feedItem(jsonItem *json.Item) *Item {
-	return &Item{
+func (t *DefaultJSONTranslator) feedItem(jsonItem *json.Item,
+	opts *options.Parse,
+) *Item {
+	item := &Item{
 		GUID:            jsonItem.ID,
 		Link:            jsonItem.URL,
 		Links:           jsonItem.Links(),
+		LinkDetails:     t.itemLinkDetails(jsonItem),
+		ExternalURL:     jsonItem.ExternalURL,
 		Title:           jsonItem.Title,
 		Content:         jsonItem.Content(),
 		Description:     jsonItem.Summary,
 		Image:           t.itemImage(jsonItem),
+		BannerImage:     t.itemBannerImage(jsonItem),
 		Published:       jsonItem.DatePublished,
 		PublishedParsed: jsonItem.PublishedParsed(),
 		Updated:         jsonItem.DateModified,
 		UpdatedParsed:   jsonItem.UpdatedParsed(),
 		Author:          t.itemAuthor(jsonItem),
 		Authors:         t.itemAuthors(jsonItem),
+		Language:        jsonItem.Language,
 		Categories:      jsonItem.Tags,
 		Enclosures:      t.itemEnclosures(jsonItem),
-
-		// TODO ExternalURL is missing in global Feed
-		// TODO BannerImage is missing in global Feed
 	}
+	return withContentFallback(item, opts,
+		func(source options.ContentSource) string {
+			return t.itemContentFrom(jsonItem, source)
+		})
+}
+
+// itemContentFrom returns text of jsonItem from given source of
+// [options.Parse.ContentFallbackChain].
+func (t *DefaultJSONTranslator) itemContentFrom(jsonItem *json.Item,
+	source options.ContentSource,
+) string {
+	switch source {
+	case options.ContentSourceContent:
+		return cmp.Or(jsonItem.ContentHTML, jsonItem.ContentText)
+	case options.ContentSourceDescription:
+		return jsonItem.Summary
+	}
+	return ""
 }
 
 func (t *DefaultJSONTranslator) feedAuthor(json *json.Feed) *Person {
@@ -369,9 +755,8 @@ func (t *DefaultJSONTranslator) feedAuthor(json *json.Feed) *Person {
 	}
 
 	name, address := shared.ParseNameAddress(json.Author.Name)
-	// Author.URL is missing in global feed
 	// Author.Avatar is missing in global feed
-	return &Person{Name: name, Email: address}
+	return &Person{Name: name, Email: address, URL: json.Author.URL}
 }
 
 func (t *DefaultJSONTranslator) feedAuthors(json *json.Feed) []*Person {
@@ -379,7 +764,7 @@ func (t *DefaultJSONTranslator) feedAuthors(json *json.Feed) []*Person {
 		authors := make([]*Person, len(json.Authors))
 		for i, a := range json.Authors {
 			name, address := shared.ParseNameAddress(a.Name)
-			authors[i] = &Person{Name: name, Email: address}
+			authors[i] = &Person{Name: name, Email: address, URL: a.URL}
 		}
 		return authors
 	}
@@ -388,7 +773,6 @@ func (t *DefaultJSONTranslator) feedAuthors(json *json.Feed) []*Person {
 		return []*Person{author}
 	}
 
-	// Author.URL is missing in global feed
 	// Author.Avatar is missing in global feed
 	return nil
 }
@@ -405,23 +789,39 @@ func (t *DefaultJSONTranslator) feedImage(json *json.Feed) *Image {
 	return nil
 }
 
-func (t *DefaultJSONTranslator) feedItems(json *json.Feed) []*Item {
+func (t *DefaultJSONTranslator) feedItems(json *json.Feed,
+	opts *options.Parse,
+) []*Item {
 	items := make([]*Item, len(json.Items))
 	for i, it := range json.Items {
-		items[i] = t.feedItem(it)
+		items[i] = t.feedItem(it, opts)
+		items[i].Index = i
+		if items[i].Language == "" {
+			items[i].Language = json.Language
+		}
 	}
 	return items
 }
 
+func (t *DefaultJSONTranslator) itemLinkDetails(jsonItem *json.Item) []Link {
+	var links []Link
+	if s := strings.TrimSpace(jsonItem.URL); s != "" {
+		links = append(links, Link{Href: s, Rel: "alternate"})
+	}
+	if s := strings.TrimSpace(jsonItem.ExternalURL); s != "" {
+		links = append(links, Link{Href: s, Rel: "related"})
+	}
+	return links
+}
+
 func (t *DefaultJSONTranslator) itemAuthor(jsonItem *json.Item) *Person {
 	if jsonItem.Author == nil {
 		return nil
 	}
 
 	name, address := shared.ParseNameAddress(jsonItem.Author.Name)
-	// Author.URL is missing in global feed
 	// Author.Avatar is missing in global feed
-	return &Person{Name: name, Email: address}
+	return &Person{Name: name, Email: address, URL: jsonItem.Author.URL}
 }
 
 func (t *DefaultJSONTranslator) itemAuthors(jsonItem *json.Item) []*Person {
@@ -429,7 +829,7 @@ func (t *DefaultJSONTranslator) itemAuthors(jsonItem *json.Item) []*Person {
 		authors := make([]*Person, len(jsonItem.Authors))
 		for i, a := range jsonItem.Authors {
 			name, address := shared.ParseNameAddress(a.Name)
-			authors[i] = &Person{Name: name, Email: address}
+			authors[i] = &Person{Name: name, Email: address, URL: a.URL}
 		}
 		return authors
 	}
@@ -437,7 +837,6 @@ func (t *DefaultJSONTranslator) itemAuthors(jsonItem *json.Item) []*Person {
 	if author := t.itemAuthor(jsonItem); author != nil {
 		return []*Person{author}
 	}
-	// Author.URL is missing in global feed
 	// Author.Avatar is missing in global feed
 	return nil
 }
@@ -449,6 +848,13 @@ func (t *DefaultJSONTranslator) itemImage(jsonItem *json.Item) *Image {
 	return nil
 }
 
+func (t *DefaultJSONTranslator) itemBannerImage(jsonItem *json.Item) *Image {
+	if jsonItem.BannerImage != "" {
+		return &Image{URL: jsonItem.BannerImage}
+	}
+	return nil
+}
+
 func (t *DefaultJSONTranslator) itemEnclosures(jsonItem *json.Item) []*Enclosure {
 	if jsonItem.Attachments == nil {
 		return nil
@@ -466,3 +872,102 @@ func (t *DefaultJSONTranslator) itemEnclosures(jsonItem *json.Item) []*Enclosure
 	}
 	return enclosures
 }
+
+// resolveItemLinks resolves relative links of feed items against home page URL
+// of the feed, if it's enabled by opts. Relative or missing home page URL is
+// resolved against FetchedURL of opts.
+// withContentFallback fills Content and Description of item by
+// [options.Parse.ContentFallbackChain], if it's configured. from returns text
+// of the source item by its source.
+func withContentFallback(item *Item, opts *options.Parse,
+	from func(source options.ContentSource) string,
+) *Item {
+	if opts == nil || len(opts.ContentFallbackChain) == 0 {
+		return item
+	}
+	chain := opts.ContentFallbackChain
+
+	item.Description = ""
+	for _, source := range chain {
+		if source == options.ContentSourceContent {
+			continue
+		} else if s := from(source); s != "" {
+			item.Description = s
+			break
+		}
+	}
+
+	// Content falls back only to sources after ContentSourceContent.
+	i := slices.Index(chain, options.ContentSourceContent)
+	if i < 0 {
+		return item
+	}
+
+	item.Content = ""
+	for _, source := range chain[i:] {
+		if s := from(source); s != "" {
+			item.Content = s
+			break
+		}
+	}
+	return item
+}
+
+func resolveItemLinks(feed *Feed, opts *options.Parse) {
+	if opts == nil || !opts.ResolveRelativeLinks || len(feed.Items) == 0 {
+		return
+	}
+
+	base := baseURL(feed.Link, opts.FetchedURL)
+	if base == nil {
+		return
+	}
+
+	for _, item := range feed.Items {
+		item.Link = resolveLink(base, item.Link)
+		for i := range item.LinkDetails {
+			l := &item.LinkDetails[i]
+			l.Href = resolveLink(base, l.Href)
+		}
+		for _, enc := range item.Enclosures {
+			enc.URL = resolveLink(base, enc.URL)
+		}
+		if len(item.Links) == 0 {
+			continue
+		}
+		links := make([]string, len(item.Links))
+		for i, link := range item.Links {
+			links[i] = resolveLink(base, link)
+		}
+		item.Links = links
+	}
+}
+
+// baseURL returns absolute home page URL, resolved against fetchedURL if
+// needed, or nil if there is no absolute URL.
+func baseURL(homePage, fetchedURL string) *url.URL {
+	base, err := url.Parse(homePage)
+	if err != nil {
+		return nil
+	} else if base.IsAbs() {
+		return base
+	}
+
+	fetched, err := url.Parse(fetchedURL)
+	if err != nil || !fetched.IsAbs() {
+		return nil
+	}
+	return fetched.ResolveReference(base)
+}
+
+func resolveLink(base *url.URL, link string) string {
+	if link == "" {
+		return link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil || u.IsAbs() {
+		return link
+	}
+	return base.ResolveReference(u).String()
+}
@@ -4,10 +4,14 @@ import (
 	"bytes"
 	jsonEncoding "encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,6 +19,7 @@ import (
 	"github.com/dsh2dsh/gofeed/v2"
 	"github.com/dsh2dsh/gofeed/v2/atom"
 	"github.com/dsh2dsh/gofeed/v2/json"
+	"github.com/dsh2dsh/gofeed/v2/options"
 	"github.com/dsh2dsh/gofeed/v2/rss"
 )
 
@@ -266,3 +271,314 @@ func TestDisableContentImageScan(t *testing.T) {
 	assert.Nil(t, out.Image)
 	assert.Nil(t, out.Items[0].Image)
 }
+
+func TestResolveRelativeLinks(t *testing.T) {
+	const dirPath = "testdata/translator/resolve_relative_links"
+	files, _ := filepath.Glob(path.Join(dirPath, "*.xml"))
+	require.NotEmpty(t, files)
+
+	for _, f := range files {
+		base := filepath.Base(f)
+		name := strings.TrimSuffix(base, filepath.Ext(base))
+
+		t.Run(name, func(t *testing.T) {
+			e, err := os.ReadFile(path.Join(dirPath, name) + ".json")
+			require.NoError(t, err)
+
+			var expected gofeed.Feed
+			require.NoError(t, jsonEncoding.Unmarshal(e, &expected))
+
+			b, err := os.ReadFile(f)
+			require.NoError(t, err)
+
+			fp := gofeed.NewParser(options.WithResolveRelativeLinks(true))
+			actual, err := fp.Parse(bytes.NewReader(b))
+			require.NoError(t, err)
+			assert.Equal(t, &expected, actual)
+
+			actual, err = gofeed.NewParser().Parse(bytes.NewReader(b))
+			require.NoError(t, err)
+			require.NotEmpty(t, actual.Items)
+			assert.Equal(t, "posts/first", actual.Items[0].Link)
+		})
+	}
+}
+
+func TestFetchedURL(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/old", http.RedirectHandler("/blog/feed.xml",
+		http.StatusMovedPermanently))
+	mux.HandleFunc("/blog/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<rss version="2.0"><channel>
+<item><link>posts/first</link></item>
+</channel></rss>`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL + "/old")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	fetchedURL := resp.Request.URL.String()
+	fp := gofeed.NewParser(options.WithResolveRelativeLinks(true))
+	feed, err := fp.Parse(resp.Body, options.WithFetchedURL(fetchedURL))
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/blog/feed.xml", feed.FetchedURL)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, srv.URL+"/blog/posts/first", feed.Items[0].Link)
+}
+
+// FetchedURL of one feed must not leak into the next feed, parsed by the same
+// parser.
+func TestFetchedURL_reuseParser(t *testing.T) {
+	const feedData = `<rss version="2.0"><channel>
+<item><link>posts/first</link></item>
+</channel></rss>`
+
+	fp := gofeed.NewParser(options.WithResolveRelativeLinks(true))
+	feed, err := fp.Parse(strings.NewReader(feedData),
+		options.WithFetchedURL("http://example.org/blog/feed.xml"))
+	require.NoError(t, err)
+	assert.Equal(t, "http://example.org/blog/feed.xml", feed.FetchedURL)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "http://example.org/blog/posts/first", feed.Items[0].Link)
+
+	feed, err = fp.Parse(strings.NewReader(feedData))
+	require.NoError(t, err)
+	assert.Empty(t, feed.FetchedURL)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "posts/first", feed.Items[0].Link)
+
+	feed, err = fp.ParseWithType(strings.NewReader(feedData), gofeed.FeedTypeRSS,
+		options.WithFetchedURL("http://example.org/news/rss"))
+	require.NoError(t, err)
+	assert.Equal(t, "http://example.org/news/rss", feed.FetchedURL)
+
+	feed, err = fp.ParseWithType(strings.NewReader(feedData), gofeed.FeedTypeRSS)
+	require.NoError(t, err)
+	assert.Empty(t, feed.FetchedURL)
+
+	fp = gofeed.NewParser(options.WithFetchedURL("http://example.org/feed"))
+	for range 2 {
+		feed, err = fp.Parse(strings.NewReader(feedData))
+		require.NoError(t, err)
+		assert.Equal(t, "http://example.org/feed", feed.FetchedURL)
+	}
+}
+
+func TestFromRSS(t *testing.T) {
+	pubDate := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+	feed, err := gofeed.FromRSS(&rss.Feed{
+		Title:    "Feed Title",
+		Links:    []string{"http://example.org/"},
+		Language: "en",
+		Items: []*rss.Item{
+			{
+				Title:         "Item Title",
+				Links:         []string{"http://example.org/item"},
+				PubDate:       "Tue, 02 Jan 2024 10:00:00 GMT",
+				PubDateParsed: &pubDate,
+			},
+		},
+		Version: "2.0",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Feed Title", feed.Title)
+	assert.Equal(t, "http://example.org/", feed.Link)
+	assert.Equal(t, "rss", feed.FeedType)
+	assert.Equal(t, "2.0", feed.FeedVersion)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+	assert.Equal(t, "Item Title", item.Title)
+	assert.Equal(t, "http://example.org/item", item.Link)
+	assert.Equal(t, &pubDate, item.PublishedParsed)
+	assert.Equal(t, "en", item.Language)
+}
+
+func TestFromAtom(t *testing.T) {
+	feed, err := gofeed.FromAtom(&atom.Feed{
+		Title:   "Feed Title",
+		Entries: []*atom.Entry{{Title: "Entry Title", ID: "urn:entry:1"}},
+		Version: "1.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Feed Title", feed.Title)
+	assert.Equal(t, "atom", feed.FeedType)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "urn:entry:1", feed.Items[0].GUID)
+}
+
+func TestFromJSON(t *testing.T) {
+	feed, err := gofeed.FromJSON(&json.Feed{
+		Title:   "Feed Title",
+		Items:   []*json.Item{{ID: "1", URL: "http://example.org/1"}},
+		Version: "https://jsonfeed.org/version/1.1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Feed Title", feed.Title)
+	assert.Equal(t, "json", feed.FeedType)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "http://example.org/1", feed.Items[0].Link)
+}
+
+func TestDefaultRSSTranslator_contentFallbackChain(t *testing.T) {
+	const feedData = `<rss version="2.0"
+  xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"
+  xmlns:media="http://search.yahoo.com/mrss/">
+<channel>
+  <item>
+    <itunes:summary>iTunes Summary</itunes:summary>
+    <media:description type="html">Media Description</media:description>
+  </item>
+</channel>
+</rss>`
+
+	tests := []struct {
+		name        string
+		opts        []options.Option
+		description string
+		content     string
+	}{
+		{
+			name:        "default",
+			description: "iTunes Summary",
+		},
+		{
+			name: "default chain",
+			opts: []options.Option{
+				options.WithContentFallbackChain(
+					options.DefaultContentFallbackChain...),
+			},
+			description: "iTunes Summary",
+		},
+		{
+			name: "media first",
+			opts: []options.Option{
+				options.WithContentFallbackChain(
+					options.ContentSourceDescription,
+					options.ContentSourceMedia,
+					options.ContentSourceITunes),
+			},
+			description: "Media Description",
+		},
+		{
+			name: "content falls back",
+			opts: []options.Option{
+				options.WithContentFallbackChain(
+					options.ContentSourceContent,
+					options.ContentSourceDescription,
+					options.ContentSourceMedia,
+					options.ContentSourceITunes),
+			},
+			description: "Media Description",
+			content:     "Media Description",
+		},
+		{
+			name: "content falls back after content only",
+			opts: []options.Option{
+				options.WithContentFallbackChain(
+					options.ContentSourceITunes,
+					options.ContentSourceContent,
+					options.ContentSourceMedia),
+			},
+			description: "iTunes Summary",
+			content:     "Media Description",
+		},
+		{
+			name: "without matching sources",
+			opts: []options.Option{
+				options.WithContentFallbackChain(options.ContentSourceAtom),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser(tt.opts...).Parse(
+				strings.NewReader(feedData))
+			require.NoError(t, err)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, tt.description, feed.Items[0].Description)
+			assert.Equal(t, tt.content, feed.Items[0].Content)
+		})
+	}
+}
+
+func TestContentFallbackChain_atomJSON(t *testing.T) {
+	const atomFeed = `<feed xmlns="http://www.w3.org/2005/Atom"
+  xmlns:media="http://search.yahoo.com/mrss/">
+  <entry>
+    <summary>Summary</summary>
+    <media:description type="html">Media Description</media:description>
+  </entry>
+</feed>`
+
+	const jsonFeed = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "items": [{"id": "1", "summary": "Summary"}]
+}`
+
+	tests := []struct {
+		name        string
+		feed        string
+		opts        []options.Option
+		description string
+		content     string
+	}{
+		{
+			name:        "atom default",
+			feed:        atomFeed,
+			description: "Summary",
+			content:     "Summary",
+		},
+		{
+			name: "atom media first",
+			feed: atomFeed,
+			opts: []options.Option{
+				options.WithContentFallbackChain(
+					options.ContentSourceContent,
+					options.ContentSourceMedia,
+					options.ContentSourceDescription),
+			},
+			description: "Media Description",
+			content:     "Media Description",
+		},
+		{
+			name: "atom without content",
+			feed: atomFeed,
+			opts: []options.Option{
+				options.WithContentFallbackChain(options.ContentSourceContent),
+			},
+		},
+		{
+			name:        "json default",
+			feed:        jsonFeed,
+			description: "Summary",
+			content:     "Summary",
+		},
+		{
+			name: "json without content",
+			feed: jsonFeed,
+			opts: []options.Option{
+				options.WithContentFallbackChain(
+					options.ContentSourceDescription,
+					options.ContentSourceContent),
+			},
+			description: "Summary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := gofeed.NewParser(tt.opts...).Parse(
+				strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			require.Len(t, feed.Items, 1)
+			assert.Equal(t, tt.description, feed.Items[0].Description)
+			assert.Equal(t, tt.content, feed.Items[0].Content)
+		})
+	}
+}
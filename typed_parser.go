@@ -0,0 +1,56 @@
+package gofeed
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dsh2dsh/gofeed/v2/atom"
+	"github.com/dsh2dsh/gofeed/v2/json"
+	"github.com/dsh2dsh/gofeed/v2/options"
+	"github.com/dsh2dsh/gofeed/v2/rss"
+)
+
+// NewTypedParser detects type of the feed by the beginning of r, without
+// reading the whole feed, like [options.WithStreaming] does. It returns type of
+// the feed and a function, which parses the rest of r into *rss.Feed,
+// *atom.Feed or *json.Feed, without translation into the universal feed. It
+// returns an error wrapping [ErrFeedTypeNotDetected], if the type can't be
+// detected.
+func NewTypedParser(r io.Reader, opts ...options.Option,
+) (FeedType, func() (any, error), error) {
+	var parseOpts options.Parse
+	parseOpts.Apply(opts...)
+	feedType, r, err := detectFeedReader(r, &parseOpts)
+	if err != nil {
+		return FeedTypeUnknown, nil,
+			fmt.Errorf("%w: %w", ErrFeedTypeNotDetected, err)
+	}
+
+	var parse func() (any, error)
+	switch feedType {
+	case FeedTypeRSS:
+		parse = func() (any, error) {
+			return typedFeed(rss.NewParser().Parse(r, options.From(parseOpts)))
+		}
+	case FeedTypeAtom:
+		parse = func() (any, error) {
+			return typedFeed(atom.NewParser().Parse(r, options.From(parseOpts)))
+		}
+	case FeedTypeJSON:
+		parse = func() (any, error) {
+			return typedFeed(json.NewParser().Parse(r, options.From(parseOpts)))
+		}
+	default:
+		return FeedTypeUnknown, nil, ErrFeedTypeNotDetected
+	}
+	return feedType, parse, nil
+}
+
+// typedFeed returns feed as any, or nil if err isn't nil, so the caller never
+// gets typed nil.
+func typedFeed[T any](feed *T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
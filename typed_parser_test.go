@@ -0,0 +1,88 @@
+package gofeed_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dsh2dsh/gofeed/v2"
+	"github.com/dsh2dsh/gofeed/v2/atom"
+	"github.com/dsh2dsh/gofeed/v2/json"
+	"github.com/dsh2dsh/gofeed/v2/rss"
+)
+
+func TestNewTypedParser(t *testing.T) {
+	f, err := os.Open("testdata/parser/rss_feed.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	feedType, parse, err := gofeed.NewTypedParser(iotest.OneByteReader(f))
+	require.NoError(t, err)
+	assert.Equal(t, gofeed.FeedTypeRSS, feedType)
+	require.NotNil(t, parse)
+
+	feed, err := parse()
+	require.NoError(t, err)
+	rssFeed, ok := feed.(*rss.Feed)
+	require.True(t, ok, "want *rss.Feed, got %T", feed)
+	assert.NotEmpty(t, rssFeed.Title)
+}
+
+func TestNewTypedParser_types(t *testing.T) {
+	tests := []struct {
+		name     string
+		feed     string
+		feedType gofeed.FeedType
+		assert   func(t *testing.T, feed any)
+	}{
+		{
+			name:     "atom",
+			feed:     `<feed xmlns="http://www.w3.org/2005/Atom"><title>t</title></feed>`,
+			feedType: gofeed.FeedTypeAtom,
+			assert: func(t *testing.T, feed any) {
+				require.IsType(t, &atom.Feed{}, feed)
+				assert.Equal(t, "t", feed.(*atom.Feed).Title)
+			},
+		},
+		{
+			name:     "json",
+			feed:     `{"version": "https://jsonfeed.org/version/1.1", "title": "t"}`,
+			feedType: gofeed.FeedTypeJSON,
+			assert: func(t *testing.T, feed any) {
+				require.IsType(t, &json.Feed{}, feed)
+				assert.Equal(t, "t", feed.(*json.Feed).Title)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedType, parse, err := gofeed.NewTypedParser(strings.NewReader(tt.feed))
+			require.NoError(t, err)
+			assert.Equal(t, tt.feedType, feedType)
+
+			feed, err := parse()
+			require.NoError(t, err)
+			tt.assert(t, feed)
+		})
+	}
+}
+
+func TestNewTypedParser_errors(t *testing.T) {
+	feedType, parse, err := gofeed.NewTypedParser(
+		strings.NewReader(`<html><body>Not a feed</body></html>`))
+	require.ErrorIs(t, err, gofeed.ErrFeedTypeNotDetected)
+	assert.Equal(t, gofeed.FeedTypeUnknown, feedType)
+	assert.Nil(t, parse)
+
+	_, parse, err = gofeed.NewTypedParser(
+		strings.NewReader(`<rss version="2.0"><channel><title>`))
+	require.NoError(t, err)
+	feed, err := parse()
+	require.Error(t, err)
+	assert.Nil(t, feed)
+}